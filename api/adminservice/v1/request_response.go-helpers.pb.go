@@ -177,6 +177,80 @@ func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RestoreWorkflowExecutionFromArchiveRequest to the protobuf v3 wire format
+func (val *RestoreWorkflowExecutionFromArchiveRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreWorkflowExecutionFromArchiveRequest from the protobuf v3 wire format
+func (val *RestoreWorkflowExecutionFromArchiveRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreWorkflowExecutionFromArchiveRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreWorkflowExecutionFromArchiveRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreWorkflowExecutionFromArchiveRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreWorkflowExecutionFromArchiveRequest
+	switch t := that.(type) {
+	case *RestoreWorkflowExecutionFromArchiveRequest:
+		that1 = t
+	case RestoreWorkflowExecutionFromArchiveRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RestoreWorkflowExecutionFromArchiveResponse to the protobuf v3 wire format
+func (val *RestoreWorkflowExecutionFromArchiveResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreWorkflowExecutionFromArchiveResponse from the protobuf v3 wire format
+func (val *RestoreWorkflowExecutionFromArchiveResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreWorkflowExecutionFromArchiveResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreWorkflowExecutionFromArchiveResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreWorkflowExecutionFromArchiveResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreWorkflowExecutionFromArchiveResponse
+	switch t := that.(type) {
+	case *RestoreWorkflowExecutionFromArchiveResponse:
+		that1 = t
+	case RestoreWorkflowExecutionFromArchiveResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeMutableStateRequest to the protobuf v3 wire format
 func (val *DescribeMutableStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -436,35 +510,405 @@ func (this *GetShardRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type GetShardResponse to the protobuf v3 wire format
-func (val *GetShardResponse) Marshal() ([]byte, error) {
+// Marshal an object of type GetShardResponse to the protobuf v3 wire format
+func (val *GetShardResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetShardResponse from the protobuf v3 wire format
+func (val *GetShardResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetShardResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetShardResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetShardResponse
+	switch t := that.(type) {
+	case *GetShardResponse:
+		that1 = t
+	case GetShardResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListHistoryTasksRequest to the protobuf v3 wire format
+func (val *ListHistoryTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListHistoryTasksRequest from the protobuf v3 wire format
+func (val *ListHistoryTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListHistoryTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListHistoryTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListHistoryTasksRequest
+	switch t := that.(type) {
+	case *ListHistoryTasksRequest:
+		that1 = t
+	case ListHistoryTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListHistoryTasksResponse to the protobuf v3 wire format
+func (val *ListHistoryTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListHistoryTasksResponse from the protobuf v3 wire format
+func (val *ListHistoryTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListHistoryTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListHistoryTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListHistoryTasksResponse
+	switch t := that.(type) {
+	case *ListHistoryTasksResponse:
+		that1 = t
+	case ListHistoryTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type Task to the protobuf v3 wire format
+func (val *Task) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type Task from the protobuf v3 wire format
+func (val *Task) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *Task) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two Task values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *Task) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *Task
+	switch t := that.(type) {
+	case *Task:
+		that1 = t
+	case Task:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHistoryQueueRequest to the protobuf v3 wire format
+func (val *DescribeHistoryQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryQueueRequest from the protobuf v3 wire format
+func (val *DescribeHistoryQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryQueueRequest
+	switch t := that.(type) {
+	case *DescribeHistoryQueueRequest:
+		that1 = t
+	case DescribeHistoryQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHistoryQueueResponse to the protobuf v3 wire format
+func (val *DescribeHistoryQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryQueueResponse from the protobuf v3 wire format
+func (val *DescribeHistoryQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryQueueResponse
+	switch t := that.(type) {
+	case *DescribeHistoryQueueResponse:
+		that1 = t
+	case DescribeHistoryQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryQueueReader to the protobuf v3 wire format
+func (val *HistoryQueueReader) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryQueueReader from the protobuf v3 wire format
+func (val *HistoryQueueReader) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryQueueReader) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryQueueReader values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryQueueReader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryQueueReader
+	switch t := that.(type) {
+	case *HistoryQueueReader:
+		that1 = t
+	case HistoryQueueReader:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryQueueSlice to the protobuf v3 wire format
+func (val *HistoryQueueSlice) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryQueueSlice from the protobuf v3 wire format
+func (val *HistoryQueueSlice) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryQueueSlice) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryQueueSlice values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryQueueSlice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryQueueSlice
+	switch t := that.(type) {
+	case *HistoryQueueSlice:
+		that1 = t
+	case HistoryQueueSlice:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryQueueAlert to the protobuf v3 wire format
+func (val *HistoryQueueAlert) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryQueueAlert from the protobuf v3 wire format
+func (val *HistoryQueueAlert) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryQueueAlert) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryQueueAlert values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryQueueAlert) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryQueueAlert
+	switch t := that.(type) {
+	case *HistoryQueueAlert:
+		that1 = t
+	case HistoryQueueAlert:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExecuteHistoryQueueActionRequest to the protobuf v3 wire format
+func (val *ExecuteHistoryQueueActionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExecuteHistoryQueueActionRequest from the protobuf v3 wire format
+func (val *ExecuteHistoryQueueActionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExecuteHistoryQueueActionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExecuteHistoryQueueActionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExecuteHistoryQueueActionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExecuteHistoryQueueActionRequest
+	switch t := that.(type) {
+	case *ExecuteHistoryQueueActionRequest:
+		that1 = t
+	case ExecuteHistoryQueueActionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExecuteHistoryQueueActionResponse to the protobuf v3 wire format
+func (val *ExecuteHistoryQueueActionResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type GetShardResponse from the protobuf v3 wire format
-func (val *GetShardResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type ExecuteHistoryQueueActionResponse from the protobuf v3 wire format
+func (val *ExecuteHistoryQueueActionResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *GetShardResponse) Size() int {
+func (val *ExecuteHistoryQueueActionResponse) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two GetShardResponse values are equivalent by recursively
+// Equal returns whether two ExecuteHistoryQueueActionResponse values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *GetShardResponse) Equal(that interface{}) bool {
+func (this *ExecuteHistoryQueueActionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *GetShardResponse
+	var that1 *ExecuteHistoryQueueActionResponse
 	switch t := that.(type) {
-	case *GetShardResponse:
+	case *ExecuteHistoryQueueActionResponse:
 		that1 = t
-	case GetShardResponse:
+	case ExecuteHistoryQueueActionResponse:
 		that1 = &t
 	default:
 		return false
@@ -473,35 +917,35 @@ func (this *GetShardResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListHistoryTasksRequest to the protobuf v3 wire format
-func (val *ListHistoryTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type HistoryQueueSplitAction to the protobuf v3 wire format
+func (val *HistoryQueueSplitAction) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListHistoryTasksRequest from the protobuf v3 wire format
-func (val *ListHistoryTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type HistoryQueueSplitAction from the protobuf v3 wire format
+func (val *HistoryQueueSplitAction) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListHistoryTasksRequest) Size() int {
+func (val *HistoryQueueSplitAction) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListHistoryTasksRequest values are equivalent by recursively
+// Equal returns whether two HistoryQueueSplitAction values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
+func (this *HistoryQueueSplitAction) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListHistoryTasksRequest
+	var that1 *HistoryQueueSplitAction
 	switch t := that.(type) {
-	case *ListHistoryTasksRequest:
+	case *HistoryQueueSplitAction:
 		that1 = t
-	case ListHistoryTasksRequest:
+	case HistoryQueueSplitAction:
 		that1 = &t
 	default:
 		return false
@@ -510,35 +954,35 @@ func (this *ListHistoryTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type ListHistoryTasksResponse to the protobuf v3 wire format
-func (val *ListHistoryTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type HistoryQueueShrinkAction to the protobuf v3 wire format
+func (val *HistoryQueueShrinkAction) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type ListHistoryTasksResponse from the protobuf v3 wire format
-func (val *ListHistoryTasksResponse) Unmarshal(buf []byte) error {
+// Unmarshal an object of type HistoryQueueShrinkAction from the protobuf v3 wire format
+func (val *HistoryQueueShrinkAction) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *ListHistoryTasksResponse) Size() int {
+func (val *HistoryQueueShrinkAction) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two ListHistoryTasksResponse values are equivalent by recursively
+// Equal returns whether two HistoryQueueShrinkAction values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
+func (this *HistoryQueueShrinkAction) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *ListHistoryTasksResponse
+	var that1 *HistoryQueueShrinkAction
 	switch t := that.(type) {
-	case *ListHistoryTasksResponse:
+	case *HistoryQueueShrinkAction:
 		that1 = t
-	case ListHistoryTasksResponse:
+	case HistoryQueueShrinkAction:
 		that1 = &t
 	default:
 		return false
@@ -547,35 +991,35 @@ func (this *ListHistoryTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type Task to the protobuf v3 wire format
-func (val *Task) Marshal() ([]byte, error) {
+// Marshal an object of type HistoryQueuePauseAction to the protobuf v3 wire format
+func (val *HistoryQueuePauseAction) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type Task from the protobuf v3 wire format
-func (val *Task) Unmarshal(buf []byte) error {
+// Unmarshal an object of type HistoryQueuePauseAction from the protobuf v3 wire format
+func (val *HistoryQueuePauseAction) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *Task) Size() int {
+func (val *HistoryQueuePauseAction) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two Task values are equivalent by recursively
+// Equal returns whether two HistoryQueuePauseAction values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *Task) Equal(that interface{}) bool {
+func (this *HistoryQueuePauseAction) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *Task
+	var that1 *HistoryQueuePauseAction
 	switch t := that.(type) {
-	case *Task:
+	case *HistoryQueuePauseAction:
 		that1 = t
-	case Task:
+	case HistoryQueuePauseAction:
 		that1 = &t
 	default:
 		return false
@@ -2988,3 +3432,299 @@ func (this *DeepHealthCheckResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type DescribeDynamicConfigRequest to the protobuf v3 wire format
+func (val *DescribeDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeDynamicConfigRequest from the protobuf v3 wire format
+func (val *DescribeDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeDynamicConfigRequest
+	switch t := that.(type) {
+	case *DescribeDynamicConfigRequest:
+		that1 = t
+	case DescribeDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeDynamicConfigResponse to the protobuf v3 wire format
+func (val *DescribeDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeDynamicConfigResponse from the protobuf v3 wire format
+func (val *DescribeDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeDynamicConfigResponse
+	switch t := that.(type) {
+	case *DescribeDynamicConfigResponse:
+		that1 = t
+	case DescribeDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetNamespaceQuotasRequest to the protobuf v3 wire format
+func (val *SetNamespaceQuotasRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetNamespaceQuotasRequest from the protobuf v3 wire format
+func (val *SetNamespaceQuotasRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetNamespaceQuotasRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetNamespaceQuotasRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetNamespaceQuotasRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetNamespaceQuotasRequest
+	switch t := that.(type) {
+	case *SetNamespaceQuotasRequest:
+		that1 = t
+	case SetNamespaceQuotasRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetNamespaceQuotasResponse to the protobuf v3 wire format
+func (val *SetNamespaceQuotasResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetNamespaceQuotasResponse from the protobuf v3 wire format
+func (val *SetNamespaceQuotasResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetNamespaceQuotasResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetNamespaceQuotasResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetNamespaceQuotasResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetNamespaceQuotasResponse
+	switch t := that.(type) {
+	case *SetNamespaceQuotasResponse:
+		that1 = t
+	case SetNamespaceQuotasResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetNamespaceQuotasRequest to the protobuf v3 wire format
+func (val *GetNamespaceQuotasRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNamespaceQuotasRequest from the protobuf v3 wire format
+func (val *GetNamespaceQuotasRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNamespaceQuotasRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNamespaceQuotasRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNamespaceQuotasRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNamespaceQuotasRequest
+	switch t := that.(type) {
+	case *GetNamespaceQuotasRequest:
+		that1 = t
+	case GetNamespaceQuotasRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetNamespaceQuotasResponse to the protobuf v3 wire format
+func (val *GetNamespaceQuotasResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNamespaceQuotasResponse from the protobuf v3 wire format
+func (val *GetNamespaceQuotasResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNamespaceQuotasResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNamespaceQuotasResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNamespaceQuotasResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNamespaceQuotasResponse
+	switch t := that.(type) {
+	case *GetNamespaceQuotasResponse:
+		that1 = t
+	case GetNamespaceQuotasResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListNamespaceQuotasRequest to the protobuf v3 wire format
+func (val *ListNamespaceQuotasRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNamespaceQuotasRequest from the protobuf v3 wire format
+func (val *ListNamespaceQuotasRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNamespaceQuotasRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNamespaceQuotasRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNamespaceQuotasRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNamespaceQuotasRequest
+	switch t := that.(type) {
+	case *ListNamespaceQuotasRequest:
+		that1 = t
+	case ListNamespaceQuotasRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListNamespaceQuotasResponse to the protobuf v3 wire format
+func (val *ListNamespaceQuotasResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNamespaceQuotasResponse from the protobuf v3 wire format
+func (val *ListNamespaceQuotasResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNamespaceQuotasResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNamespaceQuotasResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNamespaceQuotasResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNamespaceQuotasResponse
+	switch t := that.(type) {
+	case *ListNamespaceQuotasResponse:
+		that1 = t
+	case ListNamespaceQuotasResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
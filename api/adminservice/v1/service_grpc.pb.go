@@ -42,45 +42,52 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AdminService_RebuildMutableState_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
-	AdminService_ImportWorkflowExecution_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
-	AdminService_DescribeMutableState_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
-	AdminService_DescribeHistoryHost_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
-	AdminService_GetShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
-	AdminService_CloseShard_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
-	AdminService_ListHistoryTasks_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
-	AdminService_RemoveTask_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
-	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName  = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
-	AdminService_GetWorkflowExecutionRawHistory_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistory"
-	AdminService_GetReplicationMessages_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"
-	AdminService_GetNamespaceReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages"
-	AdminService_GetDLQReplicationMessages_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages"
-	AdminService_ReapplyEvents_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents"
-	AdminService_AddSearchAttributes_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
-	AdminService_RemoveSearchAttributes_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
-	AdminService_GetSearchAttributes_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes"
-	AdminService_DescribeCluster_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"
-	AdminService_ListClusters_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/ListClusters"
-	AdminService_ListClusterMembers_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers"
-	AdminService_AddOrUpdateRemoteCluster_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster"
-	AdminService_RemoveRemoteCluster_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster"
-	AdminService_GetDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages"
-	AdminService_PurgeDLQMessages_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages"
-	AdminService_MergeDLQMessages_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages"
-	AdminService_RefreshWorkflowTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
-	AdminService_ResendReplicationTasks_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
-	AdminService_GetTaskQueueTasks_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
-	AdminService_DeleteWorkflowExecution_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
-	AdminService_StreamWorkflowReplicationMessages_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
-	AdminService_GetNamespace_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
-	AdminService_GetDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
-	AdminService_PurgeDLQTasks_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
-	AdminService_MergeDLQTasks_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
-	AdminService_DescribeDLQJob_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/DescribeDLQJob"
-	AdminService_CancelDLQJob_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
-	AdminService_AddTasks_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
-	AdminService_ListQueues_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
-	AdminService_DeepHealthCheck_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck"
+	AdminService_RebuildMutableState_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
+	AdminService_ImportWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
+	AdminService_RestoreWorkflowExecutionFromArchive_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/RestoreWorkflowExecutionFromArchive"
+	AdminService_DescribeMutableState_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
+	AdminService_DescribeHistoryHost_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
+	AdminService_GetShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
+	AdminService_CloseShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
+	AdminService_ListHistoryTasks_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
+	AdminService_DescribeHistoryQueue_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryQueue"
+	AdminService_ExecuteHistoryQueueAction_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ExecuteHistoryQueueAction"
+	AdminService_RemoveTask_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/RemoveTask"
+	AdminService_GetWorkflowExecutionRawHistoryV2_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2"
+	AdminService_GetWorkflowExecutionRawHistory_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistory"
+	AdminService_GetReplicationMessages_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationMessages"
+	AdminService_GetNamespaceReplicationMessages_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationMessages"
+	AdminService_GetDLQReplicationMessages_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/GetDLQReplicationMessages"
+	AdminService_ReapplyEvents_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/ReapplyEvents"
+	AdminService_AddSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/AddSearchAttributes"
+	AdminService_RemoveSearchAttributes_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RemoveSearchAttributes"
+	AdminService_GetSearchAttributes_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/GetSearchAttributes"
+	AdminService_DescribeCluster_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DescribeCluster"
+	AdminService_ListClusters_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListClusters"
+	AdminService_ListClusterMembers_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListClusterMembers"
+	AdminService_AddOrUpdateRemoteCluster_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/AddOrUpdateRemoteCluster"
+	AdminService_RemoveRemoteCluster_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RemoveRemoteCluster"
+	AdminService_GetDLQMessages_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/GetDLQMessages"
+	AdminService_PurgeDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages"
+	AdminService_MergeDLQMessages_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages"
+	AdminService_RefreshWorkflowTasks_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
+	AdminService_ResendReplicationTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
+	AdminService_GetTaskQueueTasks_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
+	AdminService_DeleteWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
+	AdminService_StreamWorkflowReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
+	AdminService_GetNamespace_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
+	AdminService_GetDLQTasks_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
+	AdminService_PurgeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
+	AdminService_MergeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
+	AdminService_DescribeDLQJob_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/DescribeDLQJob"
+	AdminService_CancelDLQJob_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
+	AdminService_AddTasks_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
+	AdminService_ListQueues_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
+	AdminService_DeepHealthCheck_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck"
+	AdminService_DescribeDynamicConfig_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/DescribeDynamicConfig"
+	AdminService_SetNamespaceQuotas_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/SetNamespaceQuotas"
+	AdminService_GetNamespaceQuotas_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceQuotas"
+	AdminService_ListNamespaceQuotas_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/ListNamespaceQuotas"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -93,6 +100,10 @@ type AdminServiceClient interface {
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
+	// RestoreWorkflowExecutionFromArchive reads the history of a closed workflow execution from the namespace's
+	// history archival and imports it back into primary persistence, rebuilding its mutable state.
+	// NOTE: this is experimental API
+	RestoreWorkflowExecutionFromArchive(ctx context.Context, in *RestoreWorkflowExecutionFromArchiveRequest, opts ...grpc.CallOption) (*RestoreWorkflowExecutionFromArchiveResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
@@ -100,6 +111,12 @@ type AdminServiceClient interface {
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
 	ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error)
+	// DescribeHistoryQueue returns the in-memory state of a history task queue of a shard: the slices of each
+	// reader, their predicates and pending task counts, and the outstanding queue alerts.
+	DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error)
+	// ExecuteHistoryQueueAction manually runs an action on a history task queue of a shard, such as the ones
+	// run automatically when the queue raises an alert.
+	ExecuteHistoryQueueAction(ctx context.Context, in *ExecuteHistoryQueueActionRequest, opts ...grpc.CallOption) (*ExecuteHistoryQueueActionResponse, error)
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
 	// execution in unknown to the service.
@@ -167,6 +184,16 @@ type AdminServiceClient interface {
 	AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error)
 	ListQueues(ctx context.Context, in *ListQueuesRequest, opts ...grpc.CallOption) (*ListQueuesResponse, error)
 	DeepHealthCheck(ctx context.Context, in *DeepHealthCheckRequest, opts ...grpc.CallOption) (*DeepHealthCheckResponse, error)
+	// DescribeDynamicConfig returns the dynamic config values seen by the frontend host serving the request, the
+	// effective values for the requested constraints, and the recent history of value changes on that host.
+	DescribeDynamicConfig(ctx context.Context, in *DescribeDynamicConfigRequest, opts ...grpc.CallOption) (*DescribeDynamicConfigResponse, error)
+	// SetNamespaceQuotas sets the API quotas of a namespace in the current cluster. Quotas take precedence
+	// over the limits in dynamic config. Zero values unset a quota.
+	SetNamespaceQuotas(ctx context.Context, in *SetNamespaceQuotasRequest, opts ...grpc.CallOption) (*SetNamespaceQuotasResponse, error)
+	// GetNamespaceQuotas returns the API quotas of a namespace in the current cluster.
+	GetNamespaceQuotas(ctx context.Context, in *GetNamespaceQuotasRequest, opts ...grpc.CallOption) (*GetNamespaceQuotasResponse, error)
+	// ListNamespaceQuotas lists the namespaces that have API quotas in the current cluster.
+	ListNamespaceQuotas(ctx context.Context, in *ListNamespaceQuotasRequest, opts ...grpc.CallOption) (*ListNamespaceQuotasResponse, error)
 }
 
 type adminServiceClient struct {
@@ -195,6 +222,15 @@ func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *Im
 	return out, nil
 }
 
+func (c *adminServiceClient) RestoreWorkflowExecutionFromArchive(ctx context.Context, in *RestoreWorkflowExecutionFromArchiveRequest, opts ...grpc.CallOption) (*RestoreWorkflowExecutionFromArchiveResponse, error) {
+	out := new(RestoreWorkflowExecutionFromArchiveResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreWorkflowExecutionFromArchive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error) {
 	out := new(DescribeMutableStateResponse)
 	err := c.cc.Invoke(ctx, AdminService_DescribeMutableState_FullMethodName, in, out, opts...)
@@ -240,6 +276,24 @@ func (c *adminServiceClient) ListHistoryTasks(ctx context.Context, in *ListHisto
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error) {
+	out := new(DescribeHistoryQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeHistoryQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExecuteHistoryQueueAction(ctx context.Context, in *ExecuteHistoryQueueActionRequest, opts ...grpc.CallOption) (*ExecuteHistoryQueueActionResponse, error) {
+	out := new(ExecuteHistoryQueueActionResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExecuteHistoryQueueAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error) {
 	out := new(RemoveTaskResponse)
 	err := c.cc.Invoke(ctx, AdminService_RemoveTask_FullMethodName, in, out, opts...)
@@ -550,6 +604,42 @@ func (c *adminServiceClient) DeepHealthCheck(ctx context.Context, in *DeepHealth
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeDynamicConfig(ctx context.Context, in *DescribeDynamicConfigRequest, opts ...grpc.CallOption) (*DescribeDynamicConfigResponse, error) {
+	out := new(DescribeDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetNamespaceQuotas(ctx context.Context, in *SetNamespaceQuotasRequest, opts ...grpc.CallOption) (*SetNamespaceQuotasResponse, error) {
+	out := new(SetNamespaceQuotasResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetNamespaceQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetNamespaceQuotas(ctx context.Context, in *GetNamespaceQuotasRequest, opts ...grpc.CallOption) (*GetNamespaceQuotasResponse, error) {
+	out := new(GetNamespaceQuotasResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetNamespaceQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNamespaceQuotas(ctx context.Context, in *ListNamespaceQuotasRequest, opts ...grpc.CallOption) (*ListNamespaceQuotasResponse, error) {
+	out := new(ListNamespaceQuotasResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListNamespaceQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -560,6 +650,10 @@ type AdminServiceServer interface {
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
+	// RestoreWorkflowExecutionFromArchive reads the history of a closed workflow execution from the namespace's
+	// history archival and imports it back into primary persistence, rebuilding its mutable state.
+	// NOTE: this is experimental API
+	RestoreWorkflowExecutionFromArchive(context.Context, *RestoreWorkflowExecutionFromArchiveRequest) (*RestoreWorkflowExecutionFromArchiveResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
@@ -567,6 +661,12 @@ type AdminServiceServer interface {
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
 	ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error)
+	// DescribeHistoryQueue returns the in-memory state of a history task queue of a shard: the slices of each
+	// reader, their predicates and pending task counts, and the outstanding queue alerts.
+	DescribeHistoryQueue(context.Context, *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error)
+	// ExecuteHistoryQueueAction manually runs an action on a history task queue of a shard, such as the ones
+	// run automatically when the queue raises an alert.
+	ExecuteHistoryQueueAction(context.Context, *ExecuteHistoryQueueActionRequest) (*ExecuteHistoryQueueActionResponse, error)
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
 	// execution in unknown to the service.
@@ -634,6 +734,16 @@ type AdminServiceServer interface {
 	AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error)
 	ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error)
 	DeepHealthCheck(context.Context, *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error)
+	// DescribeDynamicConfig returns the dynamic config values seen by the frontend host serving the request, the
+	// effective values for the requested constraints, and the recent history of value changes on that host.
+	DescribeDynamicConfig(context.Context, *DescribeDynamicConfigRequest) (*DescribeDynamicConfigResponse, error)
+	// SetNamespaceQuotas sets the API quotas of a namespace in the current cluster. Quotas take precedence
+	// over the limits in dynamic config. Zero values unset a quota.
+	SetNamespaceQuotas(context.Context, *SetNamespaceQuotasRequest) (*SetNamespaceQuotasResponse, error)
+	// GetNamespaceQuotas returns the API quotas of a namespace in the current cluster.
+	GetNamespaceQuotas(context.Context, *GetNamespaceQuotasRequest) (*GetNamespaceQuotasResponse, error)
+	// ListNamespaceQuotas lists the namespaces that have API quotas in the current cluster.
+	ListNamespaceQuotas(context.Context, *ListNamespaceQuotasRequest) (*ListNamespaceQuotasResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -647,6 +757,9 @@ func (UnimplementedAdminServiceServer) RebuildMutableState(context.Context, *Reb
 func (UnimplementedAdminServiceServer) ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
 }
+func (UnimplementedAdminServiceServer) RestoreWorkflowExecutionFromArchive(context.Context, *RestoreWorkflowExecutionFromArchiveRequest) (*RestoreWorkflowExecutionFromArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWorkflowExecutionFromArchive not implemented")
+}
 func (UnimplementedAdminServiceServer) DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
@@ -662,6 +775,12 @@ func (UnimplementedAdminServiceServer) CloseShard(context.Context, *CloseShardRe
 func (UnimplementedAdminServiceServer) ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryTasks not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeHistoryQueue(context.Context, *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryQueue not implemented")
+}
+func (UnimplementedAdminServiceServer) ExecuteHistoryQueueAction(context.Context, *ExecuteHistoryQueueActionRequest) (*ExecuteHistoryQueueActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteHistoryQueueAction not implemented")
+}
 func (UnimplementedAdminServiceServer) RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
 }
@@ -758,6 +877,18 @@ func (UnimplementedAdminServiceServer) ListQueues(context.Context, *ListQueuesRe
 func (UnimplementedAdminServiceServer) DeepHealthCheck(context.Context, *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeepHealthCheck not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeDynamicConfig(context.Context, *DescribeDynamicConfigRequest) (*DescribeDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) SetNamespaceQuotas(context.Context, *SetNamespaceQuotasRequest) (*SetNamespaceQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceQuotas not implemented")
+}
+func (UnimplementedAdminServiceServer) GetNamespaceQuotas(context.Context, *GetNamespaceQuotasRequest) (*GetNamespaceQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceQuotas not implemented")
+}
+func (UnimplementedAdminServiceServer) ListNamespaceQuotas(context.Context, *ListNamespaceQuotasRequest) (*ListNamespaceQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaceQuotas not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -807,6 +938,24 @@ func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RestoreWorkflowExecutionFromArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorkflowExecutionFromArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreWorkflowExecutionFromArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreWorkflowExecutionFromArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreWorkflowExecutionFromArchive(ctx, req.(*RestoreWorkflowExecutionFromArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeMutableStateRequest)
 	if err := dec(in); err != nil {
@@ -897,6 +1046,42 @@ func _AdminService_ListHistoryTasks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeHistoryQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHistoryQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeHistoryQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeHistoryQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeHistoryQueue(ctx, req.(*DescribeHistoryQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExecuteHistoryQueueAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteHistoryQueueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExecuteHistoryQueueAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExecuteHistoryQueueAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExecuteHistoryQueueAction(ctx, req.(*ExecuteHistoryQueueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveTaskRequest)
 	if err := dec(in); err != nil {
@@ -1481,6 +1666,78 @@ func _AdminService_DeepHealthCheck_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeDynamicConfig(ctx, req.(*DescribeDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetNamespaceQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetNamespaceQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetNamespaceQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetNamespaceQuotas(ctx, req.(*SetNamespaceQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetNamespaceQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNamespaceQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetNamespaceQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNamespaceQuotas(ctx, req.(*GetNamespaceQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNamespaceQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespaceQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNamespaceQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListNamespaceQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNamespaceQuotas(ctx, req.(*ListNamespaceQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1496,6 +1753,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportWorkflowExecution",
 			Handler:    _AdminService_ImportWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "RestoreWorkflowExecutionFromArchive",
+			Handler:    _AdminService_RestoreWorkflowExecutionFromArchive_Handler,
+		},
 		{
 			MethodName: "DescribeMutableState",
 			Handler:    _AdminService_DescribeMutableState_Handler,
@@ -1516,6 +1777,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListHistoryTasks",
 			Handler:    _AdminService_ListHistoryTasks_Handler,
 		},
+		{
+			MethodName: "DescribeHistoryQueue",
+			Handler:    _AdminService_DescribeHistoryQueue_Handler,
+		},
+		{
+			MethodName: "ExecuteHistoryQueueAction",
+			Handler:    _AdminService_ExecuteHistoryQueueAction_Handler,
+		},
 		{
 			MethodName: "RemoveTask",
 			Handler:    _AdminService_RemoveTask_Handler,
@@ -1640,6 +1909,22 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeepHealthCheck",
 			Handler:    _AdminService_DeepHealthCheck_Handler,
 		},
+		{
+			MethodName: "DescribeDynamicConfig",
+			Handler:    _AdminService_DescribeDynamicConfig_Handler,
+		},
+		{
+			MethodName: "SetNamespaceQuotas",
+			Handler:    _AdminService_SetNamespaceQuotas_Handler,
+		},
+		{
+			MethodName: "GetNamespaceQuotas",
+			Handler:    _AdminService_GetNamespaceQuotas_Handler,
+		},
+		{
+			MethodName: "ListNamespaceQuotas",
+			Handler:    _AdminService_ListNamespaceQuotas_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -241,6 +241,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeDLQJob(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeDLQJob), varargs...)
 }
 
+// DescribeDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) DescribeDynamicConfig(ctx context.Context, in *adminservice.DescribeDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.DescribeDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDynamicConfig indicates an expected call of DescribeDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) DescribeDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeDynamicConfig), varargs...)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockAdminServiceClient) DescribeHistoryHost(ctx context.Context, in *adminservice.DescribeHistoryHostRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +281,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryHost(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryHost), varargs...)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockAdminServiceClient) DescribeHistoryQueue(ctx context.Context, in *adminservice.DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryQueue), varargs...)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceClient) DescribeMutableState(ctx context.Context, in *adminservice.DescribeMutableStateRequest, opts ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +321,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// ExecuteHistoryQueueAction mocks base method.
+func (m *MockAdminServiceClient) ExecuteHistoryQueueAction(ctx context.Context, in *adminservice.ExecuteHistoryQueueActionRequest, opts ...grpc.CallOption) (*adminservice.ExecuteHistoryQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecuteHistoryQueueAction", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExecuteHistoryQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteHistoryQueueAction indicates an expected call of ExecuteHistoryQueueAction.
+func (mr *MockAdminServiceClientMockRecorder) ExecuteHistoryQueueAction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteHistoryQueueAction", reflect.TypeOf((*MockAdminServiceClient)(nil).ExecuteHistoryQueueAction), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -361,6 +421,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetNamespace(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespace), varargs...)
 }
 
+// GetNamespaceQuotas mocks base method.
+func (m *MockAdminServiceClient) GetNamespaceQuotas(ctx context.Context, in *adminservice.GetNamespaceQuotasRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNamespaceQuotas", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceQuotas indicates an expected call of GetNamespaceQuotas.
+func (mr *MockAdminServiceClientMockRecorder) GetNamespaceQuotas(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceQuotas", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceQuotas), varargs...)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *adminservice.GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -581,6 +661,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListNamespaceQuotas mocks base method.
+func (m *MockAdminServiceClient) ListNamespaceQuotas(ctx context.Context, in *adminservice.ListNamespaceQuotasRequest, opts ...grpc.CallOption) (*adminservice.ListNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNamespaceQuotas", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaceQuotas indicates an expected call of ListNamespaceQuotas.
+func (mr *MockAdminServiceClientMockRecorder) ListNamespaceQuotas(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceQuotas", reflect.TypeOf((*MockAdminServiceClient)(nil).ListNamespaceQuotas), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceClient) ListQueues(ctx context.Context, in *adminservice.ListQueuesRequest, opts ...grpc.CallOption) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -821,6 +921,46 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// RestoreWorkflowExecutionFromArchive mocks base method.
+func (m *MockAdminServiceClient) RestoreWorkflowExecutionFromArchive(ctx context.Context, in *adminservice.RestoreWorkflowExecutionFromArchiveRequest, opts ...grpc.CallOption) (*adminservice.RestoreWorkflowExecutionFromArchiveResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreWorkflowExecutionFromArchive", varargs...)
+	ret0, _ := ret[0].(*adminservice.RestoreWorkflowExecutionFromArchiveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkflowExecutionFromArchive indicates an expected call of RestoreWorkflowExecutionFromArchive.
+func (mr *MockAdminServiceClientMockRecorder) RestoreWorkflowExecutionFromArchive(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflowExecutionFromArchive", reflect.TypeOf((*MockAdminServiceClient)(nil).RestoreWorkflowExecutionFromArchive), varargs...)
+}
+
+// SetNamespaceQuotas mocks base method.
+func (m *MockAdminServiceClient) SetNamespaceQuotas(ctx context.Context, in *adminservice.SetNamespaceQuotasRequest, opts ...grpc.CallOption) (*adminservice.SetNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetNamespaceQuotas", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNamespaceQuotas indicates an expected call of SetNamespaceQuotas.
+func (mr *MockAdminServiceClientMockRecorder) SetNamespaceQuotas(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespaceQuotas", reflect.TypeOf((*MockAdminServiceClient)(nil).SetNamespaceQuotas), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -1136,6 +1276,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeDLQJob(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeDLQJob), arg0, arg1)
 }
 
+// DescribeDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) DescribeDynamicConfig(arg0 context.Context, arg1 *adminservice.DescribeDynamicConfigRequest) (*adminservice.DescribeDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDynamicConfig indicates an expected call of DescribeDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) DescribeDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeDynamicConfig), arg0, arg1)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockAdminServiceServer) DescribeHistoryHost(arg0 context.Context, arg1 *adminservice.DescribeHistoryHostRequest) (*adminservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -1151,6 +1306,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockAdminServiceServer) DescribeHistoryQueue(arg0 context.Context, arg1 *adminservice.DescribeHistoryQueueRequest) (*adminservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryQueue), arg0, arg1)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1 *adminservice.DescribeMutableStateRequest) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1166,6 +1336,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// ExecuteHistoryQueueAction mocks base method.
+func (m *MockAdminServiceServer) ExecuteHistoryQueueAction(arg0 context.Context, arg1 *adminservice.ExecuteHistoryQueueActionRequest) (*adminservice.ExecuteHistoryQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteHistoryQueueAction", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExecuteHistoryQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteHistoryQueueAction indicates an expected call of ExecuteHistoryQueueAction.
+func (mr *MockAdminServiceServerMockRecorder) ExecuteHistoryQueueAction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteHistoryQueueAction", reflect.TypeOf((*MockAdminServiceServer)(nil).ExecuteHistoryQueueAction), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1226,6 +1411,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetNamespace(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespace), arg0, arg1)
 }
 
+// GetNamespaceQuotas mocks base method.
+func (m *MockAdminServiceServer) GetNamespaceQuotas(arg0 context.Context, arg1 *adminservice.GetNamespaceQuotasRequest) (*adminservice.GetNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceQuotas", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceQuotas indicates an expected call of GetNamespaceQuotas.
+func (mr *MockAdminServiceServerMockRecorder) GetNamespaceQuotas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceQuotas", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceQuotas), arg0, arg1)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1391,6 +1591,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListNamespaceQuotas mocks base method.
+func (m *MockAdminServiceServer) ListNamespaceQuotas(arg0 context.Context, arg1 *adminservice.ListNamespaceQuotasRequest) (*adminservice.ListNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNamespaceQuotas", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaceQuotas indicates an expected call of ListNamespaceQuotas.
+func (mr *MockAdminServiceServerMockRecorder) ListNamespaceQuotas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceQuotas", reflect.TypeOf((*MockAdminServiceServer)(nil).ListNamespaceQuotas), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceServer) ListQueues(arg0 context.Context, arg1 *adminservice.ListQueuesRequest) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1571,6 +1786,36 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// RestoreWorkflowExecutionFromArchive mocks base method.
+func (m *MockAdminServiceServer) RestoreWorkflowExecutionFromArchive(arg0 context.Context, arg1 *adminservice.RestoreWorkflowExecutionFromArchiveRequest) (*adminservice.RestoreWorkflowExecutionFromArchiveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkflowExecutionFromArchive", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RestoreWorkflowExecutionFromArchiveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreWorkflowExecutionFromArchive indicates an expected call of RestoreWorkflowExecutionFromArchive.
+func (mr *MockAdminServiceServerMockRecorder) RestoreWorkflowExecutionFromArchive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflowExecutionFromArchive", reflect.TypeOf((*MockAdminServiceServer)(nil).RestoreWorkflowExecutionFromArchive), arg0, arg1)
+}
+
+// SetNamespaceQuotas mocks base method.
+func (m *MockAdminServiceServer) SetNamespaceQuotas(arg0 context.Context, arg1 *adminservice.SetNamespaceQuotasRequest) (*adminservice.SetNamespaceQuotasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNamespaceQuotas", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetNamespaceQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNamespaceQuotas indicates an expected call of SetNamespaceQuotas.
+func (mr *MockAdminServiceServerMockRecorder) SetNamespaceQuotas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespaceQuotas", reflect.TypeOf((*MockAdminServiceServer)(nil).SetNamespaceQuotas), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -4802,6 +4802,154 @@ func (this *ListTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeHistoryQueueRequest to the protobuf v3 wire format
+func (val *DescribeHistoryQueueRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryQueueRequest from the protobuf v3 wire format
+func (val *DescribeHistoryQueueRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryQueueRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryQueueRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryQueueRequest
+	switch t := that.(type) {
+	case *DescribeHistoryQueueRequest:
+		that1 = t
+	case DescribeHistoryQueueRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHistoryQueueResponse to the protobuf v3 wire format
+func (val *DescribeHistoryQueueResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryQueueResponse from the protobuf v3 wire format
+func (val *DescribeHistoryQueueResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryQueueResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryQueueResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryQueueResponse
+	switch t := that.(type) {
+	case *DescribeHistoryQueueResponse:
+		that1 = t
+	case DescribeHistoryQueueResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExecuteHistoryQueueActionRequest to the protobuf v3 wire format
+func (val *ExecuteHistoryQueueActionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExecuteHistoryQueueActionRequest from the protobuf v3 wire format
+func (val *ExecuteHistoryQueueActionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExecuteHistoryQueueActionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExecuteHistoryQueueActionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExecuteHistoryQueueActionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExecuteHistoryQueueActionRequest
+	switch t := that.(type) {
+	case *ExecuteHistoryQueueActionRequest:
+		that1 = t
+	case ExecuteHistoryQueueActionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExecuteHistoryQueueActionResponse to the protobuf v3 wire format
+func (val *ExecuteHistoryQueueActionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExecuteHistoryQueueActionResponse from the protobuf v3 wire format
+func (val *ExecuteHistoryQueueActionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExecuteHistoryQueueActionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExecuteHistoryQueueActionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExecuteHistoryQueueActionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExecuteHistoryQueueActionResponse
+	switch t := that.(type) {
+	case *ExecuteHistoryQueueActionResponse:
+		that1 = t
+	case ExecuteHistoryQueueActionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type CompleteNexusOperationRequest to the protobuf v3 wire format
 func (val *CompleteNexusOperationRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -104,6 +104,8 @@ const (
 	HistoryService_ListQueues_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/ListQueues"
 	HistoryService_AddTasks_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/AddTasks"
 	HistoryService_ListTasks_FullMethodName                              = "/temporal.server.api.historyservice.v1.HistoryService/ListTasks"
+	HistoryService_DescribeHistoryQueue_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryQueue"
+	HistoryService_ExecuteHistoryQueueAction_FullMethodName              = "/temporal.server.api.historyservice.v1.HistoryService/ExecuteHistoryQueueAction"
 	HistoryService_CompleteNexusOperation_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation"
 	HistoryService_InvokeStateMachineMethod_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/InvokeStateMachineMethod"
 	HistoryService_DeepHealthCheck_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/DeepHealthCheck"
@@ -314,6 +316,8 @@ type HistoryServiceClient interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error)
+	ExecuteHistoryQueueAction(ctx context.Context, in *ExecuteHistoryQueueActionRequest, opts ...grpc.CallOption) (*ExecuteHistoryQueueActionResponse, error)
 	// Complete an async Nexus Operation using a completion token. The completion state could be successful, failed, or
 	// canceled.
 	CompleteNexusOperation(ctx context.Context, in *CompleteNexusOperationRequest, opts ...grpc.CallOption) (*CompleteNexusOperationResponse, error)
@@ -910,6 +914,24 @@ func (c *historyServiceClient) ListTasks(ctx context.Context, in *ListTasksReque
 	return out, nil
 }
 
+func (c *historyServiceClient) DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error) {
+	out := new(DescribeHistoryQueueResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeHistoryQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ExecuteHistoryQueueAction(ctx context.Context, in *ExecuteHistoryQueueActionRequest, opts ...grpc.CallOption) (*ExecuteHistoryQueueActionResponse, error) {
+	out := new(ExecuteHistoryQueueActionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ExecuteHistoryQueueAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) CompleteNexusOperation(ctx context.Context, in *CompleteNexusOperationRequest, opts ...grpc.CallOption) (*CompleteNexusOperationResponse, error) {
 	out := new(CompleteNexusOperationResponse)
 	err := c.cc.Invoke(ctx, HistoryService_CompleteNexusOperation_FullMethodName, in, out, opts...)
@@ -1142,6 +1164,8 @@ type HistoryServiceServer interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	DescribeHistoryQueue(context.Context, *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error)
+	ExecuteHistoryQueueAction(context.Context, *ExecuteHistoryQueueActionRequest) (*ExecuteHistoryQueueActionResponse, error)
 	// Complete an async Nexus Operation using a completion token. The completion state could be successful, failed, or
 	// canceled.
 	CompleteNexusOperation(context.Context, *CompleteNexusOperationRequest) (*CompleteNexusOperationResponse, error)
@@ -1341,6 +1365,12 @@ func (UnimplementedHistoryServiceServer) AddTasks(context.Context, *AddTasksRequ
 func (UnimplementedHistoryServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
+func (UnimplementedHistoryServiceServer) DescribeHistoryQueue(context.Context, *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryQueue not implemented")
+}
+func (UnimplementedHistoryServiceServer) ExecuteHistoryQueueAction(context.Context, *ExecuteHistoryQueueActionRequest) (*ExecuteHistoryQueueActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteHistoryQueueAction not implemented")
+}
 func (UnimplementedHistoryServiceServer) CompleteNexusOperation(context.Context, *CompleteNexusOperationRequest) (*CompleteNexusOperationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompleteNexusOperation not implemented")
 }
@@ -2487,6 +2517,42 @@ func _HistoryService_ListTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DescribeHistoryQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHistoryQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeHistoryQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeHistoryQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeHistoryQueue(ctx, req.(*DescribeHistoryQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ExecuteHistoryQueueAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteHistoryQueueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ExecuteHistoryQueueAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ExecuteHistoryQueueAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ExecuteHistoryQueueAction(ctx, req.(*ExecuteHistoryQueueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_CompleteNexusOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CompleteNexusOperationRequest)
 	if err := dec(in); err != nil {
@@ -2792,6 +2858,14 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTasks",
 			Handler:    _HistoryService_ListTasks_Handler,
 		},
+		{
+			MethodName: "DescribeHistoryQueue",
+			Handler:    _HistoryService_DescribeHistoryQueue_Handler,
+		},
+		{
+			MethodName: "ExecuteHistoryQueueAction",
+			Handler:    _HistoryService_ExecuteHistoryQueueAction_Handler,
+		},
 		{
 			MethodName: "CompleteNexusOperation",
 			Handler:    _HistoryService_CompleteNexusOperation_Handler,
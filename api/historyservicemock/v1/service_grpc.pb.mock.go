@@ -221,6 +221,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeHistoryHost(ctx, in inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeHistoryHost), varargs...)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockHistoryServiceClient) DescribeHistoryQueue(ctx context.Context, in *historyservice.DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*historyservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeHistoryQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeHistoryQueue), varargs...)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockHistoryServiceClient) DescribeMutableState(ctx context.Context, in *historyservice.DescribeMutableStateRequest, opts ...grpc.CallOption) (*historyservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +281,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeWorkflowExecution(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// ExecuteHistoryQueueAction mocks base method.
+func (m *MockHistoryServiceClient) ExecuteHistoryQueueAction(ctx context.Context, in *historyservice.ExecuteHistoryQueueActionRequest, opts ...grpc.CallOption) (*historyservice.ExecuteHistoryQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecuteHistoryQueueAction", varargs...)
+	ret0, _ := ret[0].(*historyservice.ExecuteHistoryQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteHistoryQueueAction indicates an expected call of ExecuteHistoryQueueAction.
+func (mr *MockHistoryServiceClientMockRecorder) ExecuteHistoryQueueAction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteHistoryQueueAction", reflect.TypeOf((*MockHistoryServiceClient)(nil).ExecuteHistoryQueueAction), varargs...)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockHistoryServiceClient) ExecuteMultiOperation(ctx context.Context, in *historyservice.ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*historyservice.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1641,6 +1681,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockHistoryServiceServer) DescribeHistoryQueue(arg0 context.Context, arg1 *historyservice.DescribeHistoryQueueRequest) (*historyservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeHistoryQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeHistoryQueue), arg0, arg1)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockHistoryServiceServer) DescribeMutableState(arg0 context.Context, arg1 *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1671,6 +1726,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeWorkflowExecution(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// ExecuteHistoryQueueAction mocks base method.
+func (m *MockHistoryServiceServer) ExecuteHistoryQueueAction(arg0 context.Context, arg1 *historyservice.ExecuteHistoryQueueActionRequest) (*historyservice.ExecuteHistoryQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteHistoryQueueAction", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ExecuteHistoryQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteHistoryQueueAction indicates an expected call of ExecuteHistoryQueueAction.
+func (mr *MockHistoryServiceServerMockRecorder) ExecuteHistoryQueueAction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteHistoryQueueAction", reflect.TypeOf((*MockHistoryServiceServer)(nil).ExecuteHistoryQueueAction), arg0, arg1)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockHistoryServiceServer) ExecuteMultiOperation(arg0 context.Context, arg1 *historyservice.ExecuteMultiOperationRequest) (*historyservice.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +123,16 @@ func (c *clientImpl) DescribeDLQJob(
 	return c.client.DescribeDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DescribeDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *adminservice.DescribeHistoryHostRequest,
@@ -133,6 +143,16 @@ func (c *clientImpl) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryQueueResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeHistoryQueue(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -143,6 +163,16 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) ExecuteHistoryQueueAction(
+	ctx context.Context,
+	request *adminservice.ExecuteHistoryQueueActionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExecuteHistoryQueueActionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExecuteHistoryQueueAction(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -183,6 +213,16 @@ func (c *clientImpl) GetNamespace(
 	return c.client.GetNamespace(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.GetNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNamespaceQuotasResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetNamespaceQuotas(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -293,6 +333,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.ListNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceQuotasResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListNamespaceQuotas(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -412,3 +462,23 @@ func (c *clientImpl) ResendReplicationTasks(
 	defer cancel()
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *clientImpl) RestoreWorkflowExecutionFromArchive(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionFromArchiveRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreWorkflowExecutionFromArchiveResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RestoreWorkflowExecutionFromArchive(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.SetNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetNamespaceQuotasResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetNamespaceQuotas(ctx, request, opts...)
+}
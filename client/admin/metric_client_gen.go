@@ -159,6 +159,20 @@ func (c *metricClient) DescribeDLQJob(
 	return c.client.DescribeDLQJob(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DescribeDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeDynamicConfigResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeDynamicConfig")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *adminservice.DescribeHistoryHostRequest,
@@ -173,6 +187,20 @@ func (c *metricClient) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeHistoryQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeHistoryQueue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeHistoryQueue(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -187,6 +215,20 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) ExecuteHistoryQueueAction(
+	ctx context.Context,
+	request *adminservice.ExecuteHistoryQueueActionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ExecuteHistoryQueueActionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientExecuteHistoryQueueAction")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExecuteHistoryQueueAction(ctx, request, opts...)
+}
+
 func (c *metricClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -243,6 +285,20 @@ func (c *metricClient) GetNamespace(
 	return c.client.GetNamespace(ctx, request, opts...)
 }
 
+func (c *metricClient) GetNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.GetNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetNamespaceQuotasResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetNamespaceQuotas")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetNamespaceQuotas(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -397,6 +453,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.ListNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListNamespaceQuotasResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListNamespaceQuotas")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListNamespaceQuotas(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -564,3 +634,31 @@ func (c *metricClient) ResendReplicationTasks(
 
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *metricClient) RestoreWorkflowExecutionFromArchive(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionFromArchiveRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RestoreWorkflowExecutionFromArchiveResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRestoreWorkflowExecutionFromArchive")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RestoreWorkflowExecutionFromArchive(ctx, request, opts...)
+}
+
+func (c *metricClient) SetNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.SetNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetNamespaceQuotasResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetNamespaceQuotas")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetNamespaceQuotas(ctx, request, opts...)
+}
@@ -170,6 +170,21 @@ func (c *retryableClient) DescribeDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DescribeDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeDynamicConfigResponse, error) {
+	var resp *adminservice.DescribeDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *adminservice.DescribeHistoryHostRequest,
@@ -185,6 +200,21 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryQueueResponse, error) {
+	var resp *adminservice.DescribeHistoryQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeHistoryQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -200,6 +230,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) ExecuteHistoryQueueAction(
+	ctx context.Context,
+	request *adminservice.ExecuteHistoryQueueActionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExecuteHistoryQueueActionResponse, error) {
+	var resp *adminservice.ExecuteHistoryQueueActionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExecuteHistoryQueueAction(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -260,6 +305,21 @@ func (c *retryableClient) GetNamespace(
 	return resp, err
 }
 
+func (c *retryableClient) GetNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.GetNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNamespaceQuotasResponse, error) {
+	var resp *adminservice.GetNamespaceQuotasResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetNamespaceQuotas(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -425,6 +485,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.ListNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceQuotasResponse, error) {
+	var resp *adminservice.ListNamespaceQuotasResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListNamespaceQuotas(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -604,3 +679,33 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) RestoreWorkflowExecutionFromArchive(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionFromArchiveRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreWorkflowExecutionFromArchiveResponse, error) {
+	var resp *adminservice.RestoreWorkflowExecutionFromArchiveResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RestoreWorkflowExecutionFromArchive(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.SetNamespaceQuotasRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetNamespaceQuotasResponse, error) {
+	var resp *adminservice.SetNamespaceQuotasResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetNamespaceQuotas(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -134,6 +134,26 @@ func (c *clientImpl) DeleteWorkflowVisibilityRecord(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeHistoryQueue(
+	ctx context.Context,
+	request *historyservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeHistoryQueueResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.DescribeHistoryQueueResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeHistoryQueue(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *historyservice.DescribeMutableStateRequest,
@@ -174,6 +194,26 @@ func (c *clientImpl) DescribeWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) ExecuteHistoryQueueAction(
+	ctx context.Context,
+	request *historyservice.ExecuteHistoryQueueActionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ExecuteHistoryQueueActionResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.ExecuteHistoryQueueActionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ExecuteHistoryQueueAction(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
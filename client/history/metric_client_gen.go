@@ -145,6 +145,20 @@ func (c *metricClient) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *historyservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeHistoryQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeHistoryQueue")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeHistoryQueue(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeMutableState(
 	ctx context.Context,
 	request *historyservice.DescribeMutableStateRequest,
@@ -173,6 +187,20 @@ func (c *metricClient) DescribeWorkflowExecution(
 	return c.client.DescribeWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ExecuteHistoryQueueAction(
+	ctx context.Context,
+	request *historyservice.ExecuteHistoryQueueActionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ExecuteHistoryQueueActionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientExecuteHistoryQueueAction")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExecuteHistoryQueueAction(ctx, request, opts...)
+}
+
 func (c *metricClient) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
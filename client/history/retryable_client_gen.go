@@ -155,6 +155,21 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *historyservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeHistoryQueueResponse, error) {
+	var resp *historyservice.DescribeHistoryQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeHistoryQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeMutableState(
 	ctx context.Context,
 	request *historyservice.DescribeMutableStateRequest,
@@ -185,6 +200,21 @@ func (c *retryableClient) DescribeWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ExecuteHistoryQueueAction(
+	ctx context.Context,
+	request *historyservice.ExecuteHistoryQueueActionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ExecuteHistoryQueueActionResponse, error) {
+	var resp *historyservice.ExecuteHistoryQueueActionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExecuteHistoryQueueAction(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
@@ -187,7 +187,7 @@ func buildCLI() *cli.App {
 
 				var dynamicConfigClient dynamicconfig.Client
 				if cfg.DynamicConfigClient != nil {
-					dynamicConfigClient, err = dynamicconfig.NewFileBasedClient(cfg.DynamicConfigClient, logger, temporal.InterruptCh())
+					dynamicConfigClient, err = dynamicconfig.NewClientFromConfig(cfg.DynamicConfigClient, logger, temporal.InterruptCh())
 					if err != nil {
 						return cli.Exit(fmt.Sprintf("Unable to create dynamic config client. Error: %v", err), 1)
 					}
@@ -198,6 +198,7 @@ func buildCLI() *cli.App {
 
 				authorizer, err := authorization.GetAuthorizerFromConfig(
 					&cfg.Global.Authorization,
+					logger,
 				)
 				if err != nil {
 					return cli.Exit(fmt.Sprintf("Unable to instantiate authorizer. Error: %v", err), 1)
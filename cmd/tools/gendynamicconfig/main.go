@@ -43,10 +43,11 @@ type (
 		IsGeneric bool
 	}
 	settingPrecedence struct {
-		Name   string
-		GoArgs string
-		Expr   string
-		Index  int
+		Name     string
+		GoArgs   string
+		CallArgs string
+		Expr     string
+		Index    int
 	}
 )
 
@@ -84,23 +85,27 @@ var (
 	}
 	precedences = []*settingPrecedence{
 		{
-			Name:   "Global",
-			GoArgs: "",
-			Expr:   "[]Constraints{{}}",
+			Name:     "Global",
+			GoArgs:   "",
+			CallArgs: "",
+			Expr:     "[]Constraints{{}}",
 		},
 		{
-			Name:   "Namespace",
-			GoArgs: "namespace string",
-			Expr:   "[]Constraints{{Namespace: namespace}, {}}",
+			Name:     "Namespace",
+			GoArgs:   "namespace string",
+			CallArgs: "cs.Namespace",
+			Expr:     "[]Constraints{{Namespace: namespace}, {}}",
 		},
 		{
-			Name:   "NamespaceID",
-			GoArgs: "namespaceID string",
-			Expr:   "[]Constraints{{NamespaceID: namespaceID}, {}}",
+			Name:     "NamespaceID",
+			GoArgs:   "namespaceID string",
+			CallArgs: "cs.NamespaceID",
+			Expr:     "[]Constraints{{NamespaceID: namespaceID}, {}}",
 		},
 		{
-			Name:   "TaskQueue",
-			GoArgs: "namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType",
+			Name:     "TaskQueue",
+			GoArgs:   "namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType",
+			CallArgs: "cs.Namespace, cs.TaskQueueName, cs.TaskQueueType",
 			// A task-queue-name-only filter applies to a single task queue name across all
 			// namespaces, with higher precedence than a namespace-only filter. This is intended to
 			// be used by the default partition count and is probably not useful otherwise.
@@ -113,18 +118,21 @@ var (
 		}`,
 		},
 		{
-			Name:   "ShardID",
-			GoArgs: "shardID int32",
-			Expr:   "[]Constraints{{ShardID: shardID}, {}}",
+			Name:     "ShardID",
+			GoArgs:   "shardID int32",
+			CallArgs: "cs.ShardID",
+			Expr:     "[]Constraints{{ShardID: shardID}, {}}",
 		},
 		{
-			Name:   "TaskType",
-			GoArgs: "taskType enumsspb.TaskType",
-			Expr:   "[]Constraints{{TaskType: taskType}, {}}",
+			Name:     "TaskType",
+			GoArgs:   "taskType enumsspb.TaskType",
+			CallArgs: "cs.TaskType",
+			Expr:     "[]Constraints{{TaskType: taskType}, {}}",
 		},
 		{
-			Name:   "Destination",
-			GoArgs: "namespace string, destination string",
+			Name:     "Destination",
+			GoArgs:   "namespace string, destination string",
+			CallArgs: "cs.Namespace, cs.Destination",
 			Expr: `[]Constraints{
 			{Namespace: namespace, Destination: destination},
 			{Destination: destination},
@@ -151,6 +159,21 @@ const Precedence{{.Name}} Precedence = {{.Index}}
 `, prec)
 }
 
+func generatePrecString(w io.Writer) {
+	writeTemplatedCode(w, `
+func (p Precedence) String() string {
+	switch p {
+{{- range . }}
+	case Precedence{{.Name}}:
+		return "{{.Name}}"
+{{- end }}
+	default:
+		return "Unknown"
+	}
+}
+`, precedences)
+}
+
 func generateType(w io.Writer, tp *settingType, prec *settingPrecedence) {
 	writeTemplatedCode(w, `
 {{ if .T.IsGeneric -}}
@@ -260,6 +283,10 @@ func (s {{.P.Name}}TypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs [
 	)
 }
 
+func (s {{.P.Name}}TypedSetting[T]) effectiveValue(c *Collection, cs Constraints) any {
+	return s.Get(c)({{.P.CallArgs}})
+}
+
 {{if eq .P.Name "Global" -}}
 func GetTypedPropertyFn[T any](value T) TypedPropertyFn[T] {
 {{- else -}}
@@ -316,6 +343,7 @@ const PrecedenceUnknown Precedence = 0
 		prec.Index = idx + 1
 		generatePrecEnum(w, prec)
 	}
+	generatePrecString(w)
 	for _, tp := range types {
 		for _, prec := range precedences {
 			generateType(w, tp, prec)
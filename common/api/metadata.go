@@ -156,6 +156,12 @@ var (
 	nexusServiceMetadata = map[string]MethodMetadata{
 		"DispatchNexusTask": {Scope: ScopeNamespace, Access: AccessWrite},
 	}
+	// AdminService methods that operate on a single namespace and are available to the admins
+	// of that namespace. All other AdminService methods are cluster scoped.
+	adminServiceNamespaceMetadata = map[string]MethodMetadata{
+		"GetNamespaceQuotas": {Scope: ScopeNamespace, Access: AccessReadOnly},
+		"SetNamespaceQuotas": {Scope: ScopeNamespace, Access: AccessAdmin},
+	}
 )
 
 // GetMethodMetadata gets metadata for a given API method in one of the services exported by
@@ -169,6 +175,9 @@ func GetMethodMetadata(fullApiName string) MethodMetadata {
 	case strings.HasPrefix(fullApiName, NexusServicePrefix):
 		return nexusServiceMetadata[MethodName(fullApiName)]
 	case strings.HasPrefix(fullApiName, AdminServicePrefix):
+		if md, ok := adminServiceNamespaceMetadata[MethodName(fullApiName)]; ok {
+			return md
+		}
 		return MethodMetadata{Scope: ScopeCluster, Access: AccessAdmin}
 	default:
 		return MethodMetadata{Scope: ScopeUnknown, Access: AccessUnknown}
@@ -34,6 +34,8 @@ import (
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	expmaps "golang.org/x/exp/maps"
+
+	"go.temporal.io/server/api/adminservice/v1"
 )
 
 var publicMethodRgx = regexp.MustCompile("^[A-Z]")
@@ -48,11 +50,20 @@ func TestOperatorServiceMetadata(t *testing.T) {
 	checkService(t, tp, operatorServiceMetadata)
 }
 
+func TestAdminServiceNamespaceMetadata(t *testing.T) {
+	tp := reflect.TypeOf((*adminservice.AdminServiceServer)(nil)).Elem()
+	require.Subset(t, getMethodNames(tp), expmaps.Keys(adminServiceNamespaceMetadata))
+	checkMethods(t, tp, expmaps.Keys(adminServiceNamespaceMetadata), adminServiceNamespaceMetadata)
+}
+
 func checkService(t *testing.T, tp reflect.Type, m map[string]MethodMetadata) {
 	methods := getMethodNames(tp)
 	require.ElementsMatch(t, methods, expmaps.Keys(m),
 		"If you're adding a new method to Workflow/OperatorService, please add metadata for it in metadata.go")
+	checkMethods(t, tp, methods, m)
+}
 
+func checkMethods(t *testing.T, tp reflect.Type, methods []string, m map[string]MethodMetadata) {
 	for _, method := range methods {
 		refMethod, ok := tp.MethodByName(method)
 		require.True(t, ok)
@@ -103,11 +114,15 @@ func TestGetMethodMetadata(t *testing.T) {
 	assert.Equal(t, ScopeNamespace, md.Scope)
 	assert.Equal(t, AccessWrite, md.Access)
 
-	// all AdminService is cluster/admin
+	// AdminService is cluster/admin, except for a few namespace methods
 	md = GetMethodMetadata("/temporal.server.api.adminservice.v1.AdminService/CloseShard")
 	assert.Equal(t, ScopeCluster, md.Scope)
 	assert.Equal(t, AccessAdmin, md.Access)
 
+	md = GetMethodMetadata("/temporal.server.api.adminservice.v1.AdminService/SetNamespaceQuotas")
+	assert.Equal(t, ScopeNamespace, md.Scope)
+	assert.Equal(t, AccessAdmin, md.Access)
+
 	md = GetMethodMetadata("/OtherService/Method1")
 	assert.Equal(t, ScopeUnknown, md.Scope)
 	assert.Equal(t, AccessUnknown, md.Access)
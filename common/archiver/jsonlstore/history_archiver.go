@@ -0,0 +1,406 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// JSONL History Archiver will archive workflow histories to local disk as JSON Lines files.
+
+// Each Archive() request results in a file named in the format of
+// hash(namespaceID, workflowID, runID)_version.history.jsonl[.gz] being created under the
+// namespace_id=<namespaceID> partition of the directory specified in the URI. Each line of the
+// file is a flat JSON row describing one history event, so the files can be loaded directly by
+// analytics tools which understand hive style partitioning.
+
+// The Get() method retrieves the archived histories from the directory specified in the
+// URI. It optionally takes in a NextPageToken which specifies the workflow close failover
+// version and the index of the first history batch that should be returned. Instead of
+// NextPageToken, caller can also provide a close failover version, in which case, Get() method
+// will return history batches starting from the beginning of that history version. If neither
+// of NextPageToken or close failover version is specified, the highest close failover version
+// will be picked.
+
+package jsonlstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// URIScheme is the scheme for the jsonlstore implementation
+	URIScheme = "jsonl"
+
+	errEncodeHistory = "failed to encode history batches"
+	errMakeDirectory = "failed to make directory"
+	errWriteFile     = "failed to write history to file"
+
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+)
+
+var (
+	errInvalidFileMode = errors.New("invalid file mode")
+	errInvalidDirMode  = errors.New("invalid directory mode")
+)
+
+type (
+	historyArchiver struct {
+		container   *archiver.HistoryBootstrapContainer
+		fileMode    os.FileMode
+		dirMode     os.FileMode
+		compression string
+
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		NextBatchIdx         int
+	}
+
+	// historyRow is a single line of an archived history file.
+	historyRow struct {
+		NamespaceID          string          `json:"namespace_id"`
+		Namespace            string          `json:"namespace"`
+		WorkflowID           string          `json:"workflow_id"`
+		RunID                string          `json:"run_id"`
+		CloseFailoverVersion int64           `json:"close_failover_version"`
+		BatchIndex           int             `json:"batch_index"`
+		EventID              int64           `json:"event_id"`
+		EventTime            time.Time       `json:"event_time"`
+		EventType            string          `json:"event_type"`
+		Version              int64           `json:"version"`
+		TaskID               int64           `json:"task_id"`
+		Event                json.RawMessage `json:"event"`
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver based on jsonlstore
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.JsonlstoreArchiver,
+) (archiver.HistoryArchiver, error) {
+	return newHistoryArchiver(container, config, nil)
+}
+
+func newHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.JsonlstoreArchiver,
+	historyIterator archiver.HistoryIterator,
+) (*historyArchiver, error) {
+	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(config.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	compression, err := validateCompression(config.Compression)
+	if err != nil {
+		return nil, err
+	}
+	return &historyArchiver{
+		container:       container,
+		fileMode:        os.FileMode(fileMode),
+		dirMode:         os.FileMode(dirMode),
+		compression:     compression,
+		historyIterator: historyIterator,
+	}, nil
+}
+
+func (h *historyArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && !common.IsPersistenceTransientError(err) && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.container.Logger, request, URI.String())
+
+	if err := h.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	historyIterator := h.historyIterator
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = archiver.NewHistoryIterator(request, h.container.ExecutionManager, targetHistoryBlobSize)
+	}
+
+	var historyBatches []*historypb.History
+	for historyIterator.HasNext() {
+		historyBlob, err := historyIterator.Next(ctx)
+		if err != nil {
+			if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+				// workflow history no longer exists, may due to duplicated archival signal
+				// this may happen even in the middle of iterating history as two archival signals
+				// can be processed concurrently.
+				logger.Info(archiver.ArchiveSkippedInfoMsg)
+				return nil
+			}
+
+			logger = log.With(logger, tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if !common.IsPersistenceTransientError(err) {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			}
+			return err
+		}
+
+		if historyMutated(request, historyBlob.Body, historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
+			return archiver.ErrHistoryMutated
+		}
+
+		historyBatches = append(historyBatches, historyBlob.Body...)
+	}
+
+	encodedHistoryRows, err := encodeHistoryRows(request, historyBatches, h.compression)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+
+	dirPath := constructNamespaceDirPath(URI.Path(), request.NamespaceID)
+	if err = mkdirAll(dirPath, h.dirMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
+	}
+
+	basename := constructHistoryBasename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	filename := basename
+	if h.compression == compressionGzip {
+		filename += gzipExtension
+	}
+	if err := writeFile(path.Join(dirPath, filename), encodedHistoryRows, h.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (h *historyArchiver) Get(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+) (*archiver.GetHistoryResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetHistoryRequest.Error())
+	}
+
+	dirPath := constructNamespaceDirPath(URI.Path(), request.NamespaceID)
+	exists, err := directoryExists(dirPath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if !exists {
+		return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+	}
+
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	} else if request.CloseFailoverVersion != nil {
+		token = &getHistoryToken{
+			CloseFailoverVersion: *request.CloseFailoverVersion,
+			NextBatchIdx:         0,
+		}
+	} else {
+		highestVersion, err := getHighestVersion(dirPath, request)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		token = &getHistoryToken{
+			CloseFailoverVersion: *highestVersion,
+			NextBatchIdx:         0,
+		}
+	}
+
+	basename := constructHistoryBasename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	filepath, err := findFile(dirPath, basename)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if filepath == "" {
+		return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+	}
+
+	encodedHistoryRows, err := readFile(filepath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	historyBatches, err := decodeHistoryRows(encodedHistoryRows)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if token.NextBatchIdx > len(historyBatches) {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+	}
+	historyBatches = historyBatches[token.NextBatchIdx:]
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	numOfBatches := 0
+	for _, batch := range historyBatches {
+		response.HistoryBatches = append(response.HistoryBatches, batch)
+		numOfBatches++
+		numOfEvents += len(batch.Events)
+		if numOfEvents >= request.PageSize {
+			break
+		}
+	}
+
+	if numOfBatches < len(historyBatches) {
+		token.NextBatchIdx += numOfBatches
+		nextToken, err := serializeToken(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	return validateDirPath(URI.Path())
+}
+
+func getHighestVersion(dirPath string, request *archiver.GetHistoryRequest) (*int64, error) {
+	filenames, err := listFilesByPrefix(dirPath, constructHistoryFilenamePrefix(request.NamespaceID, request.WorkflowID, request.RunID))
+	if err != nil {
+		return nil, err
+	}
+
+	var highestVersion *int64
+	for _, filename := range filenames {
+		version, err := extractCloseFailoverVersion(filename)
+		if err != nil {
+			continue
+		}
+		if highestVersion == nil || version > *highestVersion {
+			highestVersion = &version
+		}
+	}
+	if highestVersion == nil {
+		return nil, archiver.ErrHistoryNotExist
+	}
+	return highestVersion, nil
+}
+
+func encodeHistoryRows(
+	request *archiver.ArchiveHistoryRequest,
+	historyBatches []*historypb.History,
+	compression string,
+) ([]byte, error) {
+	encoder := codec.NewJSONPBEncoder()
+	var rows []*historyRow
+	for batchIdx, batch := range historyBatches {
+		for _, event := range batch.Events {
+			encodedEvent, err := encoder.Encode(event)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, &historyRow{
+				NamespaceID:          request.NamespaceID,
+				Namespace:            request.Namespace,
+				WorkflowID:           request.WorkflowID,
+				RunID:                request.RunID,
+				CloseFailoverVersion: request.CloseFailoverVersion,
+				BatchIndex:           batchIdx,
+				EventID:              event.GetEventId(),
+				EventTime:            event.GetEventTime().AsTime(),
+				EventType:            event.GetEventType().String(),
+				Version:              event.GetVersion(),
+				TaskID:               event.GetTaskId(),
+				Event:                encodedEvent,
+			})
+		}
+	}
+	return encodeRows(rows, compression)
+}
+
+// decodeHistoryRows regroups archived event rows into the history batches they were archived from.
+func decodeHistoryRows(data []byte) ([]*historypb.History, error) {
+	rows, err := decodeRows[*historyRow](data)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+	var historyBatches []*historypb.History
+	lastBatchIdx := -1
+	for _, row := range rows {
+		event := &historypb.HistoryEvent{}
+		if err := encoder.Decode(row.Event, event); err != nil {
+			return nil, err
+		}
+		if row.BatchIndex != lastBatchIdx {
+			historyBatches = append(historyBatches, &historypb.History{})
+			lastBatchIdx = row.BatchIndex
+		}
+		batch := historyBatches[len(historyBatches)-1]
+		batch.Events = append(batch.Events, event)
+	}
+	return historyBatches, nil
+}
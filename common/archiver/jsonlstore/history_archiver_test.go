@@ -0,0 +1,393 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package jsonlstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/tests/testutils"
+)
+
+const (
+	testNamespaceID          = "test-namespace-id"
+	testNamespace            = "test-namespace"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = int64(100)
+	testPageSize             = 100
+
+	testFileModeStr = "0666"
+	testDirModeStr  = "0766"
+	testFileMode    = os.FileMode(0666)
+	testDirMode     = os.FileMode(0766)
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container *archiver.HistoryBootstrapContainer
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.HistoryBootstrapContainer{
+		Logger: log.NewNoopLogger(),
+	}
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_InvalidConfig() {
+	_, err := NewHistoryArchiver(s.container, &config.JsonlstoreArchiver{FileMode: "abc", DirMode: testDirModeStr})
+	s.Equal(errInvalidFileMode, err)
+	_, err = NewHistoryArchiver(s.container, &config.JsonlstoreArchiver{FileMode: testFileModeStr, DirMode: "abc"})
+	s.Equal(errInvalidDirMode, err)
+	_, err = NewHistoryArchiver(s.container, &config.JsonlstoreArchiver{FileMode: testFileModeStr, DirMode: testDirModeStr, Compression: "lz4"})
+	s.Equal(errInvalidCompression, err)
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "jsonl://",
+			expectedErr: errEmptyDirectoryPath,
+		},
+		{
+			URI:         "jsonl:///a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil, compressionGzip)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, historyArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil, compressionGzip)
+	request := s.newArchiveRequest()
+	request.WorkflowID = "" // an invalid request
+	err := historyArchiver.Archive(context.Background(), s.newTestURI(s.T().TempDir()), request)
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_ErrorOnReadHistory() {
+	mockCtrl := gomock.NewController(s.T())
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator, compressionGzip)
+	err := historyArchiver.Archive(context.Background(), s.newTestURI(s.T().TempDir()), s.newArchiveRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Skip_HistoryNotFound() {
+	mockCtrl := gomock.NewController(s.T())
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found")),
+	)
+
+	dir := s.T().TempDir()
+	historyArchiver := s.newTestHistoryArchiver(historyIterator, compressionGzip)
+	err := historyArchiver.Archive(context.Background(), s.newTestURI(dir), s.newArchiveRequest())
+	s.NoError(err)
+
+	exists, err := directoryExists(constructNamespaceDirPath(dir, testNamespaceID))
+	s.NoError(err)
+	s.False(exists)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_HistoryMutated() {
+	mockCtrl := gomock.NewController(s.T())
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBatches := s.newHistoryBatches()
+	historyBatches[len(historyBatches)-1].Events[0].Version = testCloseFailoverVersion + 1
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(&archiverspb.HistoryBlob{
+			Header: &archiverspb.HistoryBlobHeader{IsLast: true},
+			Body:   historyBatches,
+		}, nil),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator, compressionGzip)
+	err := historyArchiver.Archive(context.Background(), s.newTestURI(s.T().TempDir()), s.newArchiveRequest())
+	s.Equal(archiver.ErrHistoryMutated, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_NonRetryableErrorOption() {
+	mockCtrl := gomock.NewController(s.T())
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator, compressionGzip)
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := historyArchiver.Archive(
+		context.Background(),
+		s.newTestURI(s.T().TempDir()),
+		s.newArchiveRequest(),
+		archiver.GetNonRetryableErrorOption(nonRetryableErr),
+	)
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet() {
+	for _, compression := range []string{compressionGzip, compressionNone} {
+		s.Run(compression, func() {
+			dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndGet")
+			URI := s.newTestURI(dir)
+			historyBatches := s.newHistoryBatches()
+
+			mockCtrl := gomock.NewController(s.T())
+			historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+			gomock.InOrder(
+				historyIterator.EXPECT().HasNext().Return(true),
+				historyIterator.EXPECT().Next(gomock.Any()).Return(&archiverspb.HistoryBlob{
+					Header: &archiverspb.HistoryBlobHeader{IsLast: true},
+					Body:   historyBatches,
+				}, nil),
+				historyIterator.EXPECT().HasNext().Return(false),
+			)
+
+			historyArchiver := s.newTestHistoryArchiver(historyIterator, compression)
+			s.NoError(historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest()))
+
+			filename := constructHistoryBasename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+			if compression == compressionGzip {
+				filename += gzipExtension
+			}
+			s.assertFileExists(path.Join(constructNamespaceDirPath(dir, testNamespaceID), filename))
+
+			// the first page stops at the batch which reaches the page size
+			request := &archiver.GetHistoryRequest{
+				NamespaceID: testNamespaceID,
+				WorkflowID:  testWorkflowID,
+				RunID:       testRunID,
+				PageSize:    2,
+			}
+			response, err := historyArchiver.Get(context.Background(), URI, request)
+			s.NoError(err)
+			s.NotNil(response.NextPageToken)
+			protorequire.ProtoSliceEqual(s.T(), historyBatches[:1], response.HistoryBatches)
+
+			request.NextPageToken = response.NextPageToken
+			response, err = historyArchiver.Get(context.Background(), URI, request)
+			s.NoError(err)
+			s.Nil(response.NextPageToken)
+			protorequire.ProtoSliceEqual(s.T(), historyBatches[1:], response.HistoryBatches)
+		})
+	}
+}
+
+func (s *historyArchiverSuite) TestGet_Success_PickHighestVersion() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestGetHighestVersion")
+	URI := s.newTestURI(dir)
+	historyArchiver := s.newTestHistoryArchiver(nil, compressionGzip)
+
+	for _, version := range []int64{1, testCloseFailoverVersion} {
+		request := s.newArchiveRequest()
+		request.CloseFailoverVersion = version
+		historyBatches := s.newHistoryBatches()
+		for _, batch := range historyBatches {
+			for _, event := range batch.Events {
+				event.Version = version
+			}
+		}
+		s.writeHistoryBatches(dir, request, historyBatches)
+	}
+
+	response, err := historyArchiver.Get(context.Background(), URI, &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	})
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.HistoryBatches, 2)
+	s.Equal(testCloseFailoverVersion, response.HistoryBatches[0].Events[0].Version)
+
+	version := int64(1)
+	response, err = historyArchiver.Get(context.Background(), URI, &archiver.GetHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		PageSize:             testPageSize,
+		CloseFailoverVersion: &version,
+	})
+	s.NoError(err)
+	s.Equal(version, response.HistoryBatches[0].Events[0].Version)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestGetFail")
+	historyArchiver := s.newTestHistoryArchiver(nil, compressionGzip)
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+
+	_, err := historyArchiver.Get(context.Background(), s.newTestURI(dir), request)
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	s.writeHistoryBatches(dir, s.newArchiveRequest(), s.newHistoryBatches())
+
+	version := int64(1)
+	request.CloseFailoverVersion = &version
+	_, err = historyArchiver.Get(context.Background(), s.newTestURI(dir), request)
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	request.NextPageToken = []byte{'r', 'a', 'n', 'd', 'o', 'm'}
+	_, err = historyArchiver.Get(context.Background(), s.newTestURI(dir), request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	_, err = historyArchiver.Get(context.Background(), URI, request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator, compression string) *historyArchiver {
+	config := &config.JsonlstoreArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: compression,
+	}
+	archiver, err := newHistoryArchiver(s.container, config, historyIterator)
+	s.NoError(err)
+	return archiver
+}
+
+func (s *historyArchiverSuite) newTestURI(dir string) archiver.URI {
+	URI, err := archiver.NewURI(URIScheme + "://" + dir)
+	s.NoError(err)
+	return URI
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) newHistoryBatches() []*historypb.History {
+	now := time.Now().UTC()
+	return []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   common.FirstEventID,
+					EventTime: timestamppb.New(now),
+					EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+					Version:   testCloseFailoverVersion,
+				},
+				{
+					EventId:   common.FirstEventID + 1,
+					EventTime: timestamppb.New(now),
+					EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+					Version:   testCloseFailoverVersion,
+				},
+			},
+		},
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   testNextEventID - 1,
+					EventTime: timestamppb.New(now),
+					EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+					Version:   testCloseFailoverVersion,
+				},
+			},
+		},
+	}
+}
+
+func (s *historyArchiverSuite) writeHistoryBatches(dir string, request *archiver.ArchiveHistoryRequest, historyBatches []*historypb.History) {
+	data, err := encodeHistoryRows(request, historyBatches, compressionGzip)
+	s.NoError(err)
+	dirPath := constructNamespaceDirPath(dir, request.NamespaceID)
+	s.NoError(mkdirAll(dirPath, testDirMode))
+	filename := constructHistoryBasename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion) + gzipExtension
+	s.NoError(writeFile(path.Join(dirPath, filename), data, testFileMode))
+}
+
+func (s *historyArchiverSuite) assertFileExists(filepath string) {
+	exists, err := fileExists(filepath)
+	s.NoError(err)
+	s.True(exists)
+}
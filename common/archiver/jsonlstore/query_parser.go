@@ -0,0 +1,841 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package jsonlstore
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// QueryParser parses a visibility query into a filter which is evaluated against archived records.
+	// It supports the same query language as the advanced visibility stores.
+	QueryParser interface {
+		Parse(query string, saTypeMap searchattribute.NameTypeMap) (*ParsedQuery, error)
+	}
+
+	queryParser struct{}
+
+	// ParsedQuery is the result of parsing a visibility query. Besides the record filter it holds the
+	// close time range implied by the query, which is used to skip close_date partitions.
+	ParsedQuery struct {
+		filter            recordFilter
+		earliestCloseTime time.Time
+		latestCloseTime   time.Time
+		emptyResult       bool
+	}
+
+	// recordFilter reports whether a record satisfies (part of) a query.
+	recordFilter func(record *queryRecord) bool
+
+	// queryRecord is a visibility record with its search attributes decoded to typed values.
+	queryRecord struct {
+		*archiverspb.VisibilityRecord
+		searchAttributes map[string]interface{}
+	}
+
+	queryConverter struct {
+		saTypeMap             searchattribute.NameTypeMap
+		seenNamespaceDivision bool
+	}
+
+	column struct {
+		alias     string
+		name      string
+		valueType enumspb.IndexedValueType
+	}
+)
+
+const (
+	queryTemplate = "select * from dummy where %s"
+)
+
+var (
+	maxCloseTime = time.Unix(0, math.MaxInt64).UTC()
+
+	supportedComparisonOperators = []string{
+		sqlparser.EqualStr,
+		sqlparser.NotEqualStr,
+		sqlparser.LessThanStr,
+		sqlparser.GreaterThanStr,
+		sqlparser.LessEqualStr,
+		sqlparser.GreaterEqualStr,
+		sqlparser.InStr,
+		sqlparser.NotInStr,
+		sqlparser.StartsWithStr,
+		sqlparser.NotStartsWithStr,
+	}
+
+	supportedKeywordListOperators = []string{
+		sqlparser.EqualStr,
+		sqlparser.NotEqualStr,
+		sqlparser.InStr,
+		sqlparser.NotInStr,
+	}
+
+	supportedTextOperators = []string{
+		sqlparser.EqualStr,
+		sqlparser.NotEqualStr,
+	}
+
+	supportedTypesRangeCond = []enumspb.IndexedValueType{
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		enumspb.INDEXED_VALUE_TYPE_INT,
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}
+)
+
+// NewQueryParser creates a new query parser for jsonlstore
+func NewQueryParser() QueryParser {
+	return &queryParser{}
+}
+
+func (p *queryParser) Parse(queryString string, saTypeMap searchattribute.NameTypeMap) (*ParsedQuery, error) {
+	parsedQuery := &ParsedQuery{
+		earliestCloseTime: time.Time{},
+		latestCloseTime:   maxCloseTime,
+	}
+	c := &queryConverter{saTypeMap: saTypeMap}
+
+	var whereExpr sqlparser.Expr
+	if strings.TrimSpace(queryString) != "" {
+		stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, queryString))
+		if err != nil {
+			return nil, query.NewConverterError("%s: %v", query.MalformedSqlQueryErrMessage, err)
+		}
+		sel, ok := stmt.(*sqlparser.Select)
+		if !ok {
+			return nil, query.NewConverterError("%s: statement must be 'select' not %T", query.NotSupportedErrMessage, stmt)
+		}
+		if sel.OrderBy != nil {
+			return nil, query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
+		}
+		if sel.GroupBy != nil {
+			return nil, query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+		}
+		if sel.Limit != nil {
+			return nil, query.NewConverterError("%s: 'limit' clause", query.NotSupportedErrMessage)
+		}
+		if sel.Where != nil {
+			whereExpr = sel.Where.Expr
+		}
+	}
+
+	filter := func(*queryRecord) bool { return true }
+	if whereExpr != nil {
+		var err error
+		if filter, err = c.convertWhereExpr(whereExpr); err != nil {
+			return nil, err
+		}
+		c.narrowCloseTimeRange(whereExpr, parsedQuery)
+	}
+
+	// Same as the other visibility stores: if the query did not explicitly filter on
+	// TemporalNamespaceDivision, then only records without it are returned.
+	if !c.seenNamespaceDivision {
+		userFilter := filter
+		filter = func(record *queryRecord) bool {
+			return len(record.values(searchattribute.TemporalNamespaceDivision)) == 0 && userFilter(record)
+		}
+	}
+
+	parsedQuery.filter = filter
+	parsedQuery.emptyResult = parsedQuery.earliestCloseTime.After(parsedQuery.latestCloseTime)
+	return parsedQuery, nil
+}
+
+func (c *queryConverter) convertWhereExpr(expr sqlparser.Expr) (recordFilter, error) {
+	if expr == nil {
+		return nil, query.NewConverterError("%s: where expression is nil", query.InvalidExpressionErrMessage)
+	}
+
+	switch e := expr.(type) {
+	case *sqlparser.ParenExpr:
+		return c.convertWhereExpr(e.Expr)
+	case *sqlparser.NotExpr:
+		filter, err := c.convertWhereExpr(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *queryRecord) bool { return !filter(record) }, nil
+	case *sqlparser.AndExpr:
+		left, right, err := c.convertBinaryExpr(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *queryRecord) bool { return left(record) && right(record) }, nil
+	case *sqlparser.OrExpr:
+		left, right, err := c.convertBinaryExpr(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *queryRecord) bool { return left(record) || right(record) }, nil
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(e)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(e)
+	case *sqlparser.IsExpr:
+		return c.convertIsExpr(e)
+	case *sqlparser.FuncExpr:
+		return nil, query.NewConverterError("%s: function expression", query.NotSupportedErrMessage)
+	case *sqlparser.ColName:
+		return nil, query.NewConverterError("%s: incomplete expression", query.InvalidExpressionErrMessage)
+	default:
+		return nil, query.NewConverterError("%s: expression of type %T", query.NotSupportedErrMessage, e)
+	}
+}
+
+func (c *queryConverter) convertBinaryExpr(leftExpr, rightExpr sqlparser.Expr) (recordFilter, recordFilter, error) {
+	left, err := c.convertWhereExpr(leftExpr)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := c.convertWhereExpr(rightExpr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func (c *queryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (recordFilter, error) {
+	if !isSupportedOperator(supportedComparisonOperators, expr.Operator) {
+		return nil, query.NewConverterError(
+			"%s: invalid operator '%s' in `%s`",
+			query.InvalidExpressionErrMessage,
+			expr.Operator,
+			sqlparser.String(expr),
+		)
+	}
+
+	col, err := c.convertColName(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	switch col.valueType {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		if !isSupportedOperator(supportedKeywordListOperators, expr.Operator) {
+			return nil, query.NewConverterError(
+				"%s: operator '%s' not supported for KeywordList type search attribute in `%s`",
+				query.InvalidExpressionErrMessage,
+				expr.Operator,
+				sqlparser.String(expr),
+			)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_TEXT:
+		if !isSupportedOperator(supportedTextOperators, expr.Operator) {
+			return nil, query.NewConverterError(
+				"%s: operator '%s' not supported for Text type search attribute in `%s`",
+				query.InvalidExpressionErrMessage,
+				expr.Operator,
+				sqlparser.String(expr),
+			)
+		}
+	}
+
+	values, err := c.convertValueExpr(expr.Right, col)
+	if err != nil {
+		return nil, err
+	}
+	_, isTuple := expr.Right.(sqlparser.ValTuple)
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		if !isTuple {
+			return nil, query.NewConverterError(
+				"%s: right-hand side of '%s' must be a list of values",
+				query.InvalidExpressionErrMessage,
+				expr.Operator,
+			)
+		}
+	default:
+		if isTuple || len(values) != 1 {
+			return nil, query.NewConverterError(
+				"%s: right-hand side of '%s' must be a single value",
+				query.InvalidExpressionErrMessage,
+				expr.Operator,
+			)
+		}
+	}
+
+	var match func(v interface{}) bool
+	negate := false
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		if col.valueType == enumspb.INDEXED_VALUE_TYPE_TEXT {
+			match = func(v interface{}) bool { return textMatch(v, values[0]) }
+		} else {
+			match = func(v interface{}) bool { return compareEqual(v, values[0]) }
+		}
+		negate = expr.Operator == sqlparser.NotEqualStr
+	case sqlparser.InStr, sqlparser.NotInStr:
+		match = func(v interface{}) bool {
+			for _, value := range values {
+				if compareEqual(v, value) {
+					return true
+				}
+			}
+			return false
+		}
+		negate = expr.Operator == sqlparser.NotInStr
+	case sqlparser.LessThanStr:
+		match = func(v interface{}) bool { return compareMatches(v, values[0], func(cmp int) bool { return cmp < 0 }) }
+	case sqlparser.LessEqualStr:
+		match = func(v interface{}) bool { return compareMatches(v, values[0], func(cmp int) bool { return cmp <= 0 }) }
+	case sqlparser.GreaterThanStr:
+		match = func(v interface{}) bool { return compareMatches(v, values[0], func(cmp int) bool { return cmp > 0 }) }
+	case sqlparser.GreaterEqualStr:
+		match = func(v interface{}) bool { return compareMatches(v, values[0], func(cmp int) bool { return cmp >= 0 }) }
+	case sqlparser.StartsWithStr, sqlparser.NotStartsWithStr:
+		prefix, ok := values[0].(string)
+		if sqlVal, isSQLVal := expr.Right.(*sqlparser.SQLVal); !isSQLVal || sqlVal.Type != sqlparser.StrVal {
+			ok = false
+		}
+		if !ok {
+			return nil, query.NewConverterError(
+				"%s: right-hand side of '%s' must be a literal string (got: %v)",
+				query.InvalidExpressionErrMessage,
+				expr.Operator,
+				sqlparser.String(expr.Right),
+			)
+		}
+		match = func(v interface{}) bool {
+			s, ok := v.(string)
+			return ok && strings.HasPrefix(s, prefix)
+		}
+		negate = expr.Operator == sqlparser.NotStartsWithStr
+	}
+
+	return newColumnFilter(col, match, negate), nil
+}
+
+func (c *queryConverter) convertRangeCond(expr *sqlparser.RangeCond) (recordFilter, error) {
+	col, err := c.convertColName(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	if !isSupportedTypeRangeCond(col.valueType) {
+		return nil, query.NewConverterError(
+			"%s: cannot do range condition on search attribute '%s' of type %s",
+			query.InvalidExpressionErrMessage,
+			col.alias,
+			col.valueType.String(),
+		)
+	}
+	from, err := c.convertSingleValueExpr(expr.From, col)
+	if err != nil {
+		return nil, err
+	}
+	to, err := c.convertSingleValueExpr(expr.To, col)
+	if err != nil {
+		return nil, err
+	}
+
+	match := func(v interface{}) bool {
+		return compareMatches(v, from, func(cmp int) bool { return cmp >= 0 }) &&
+			compareMatches(v, to, func(cmp int) bool { return cmp <= 0 })
+	}
+	switch expr.Operator {
+	case sqlparser.BetweenStr:
+		return newColumnFilter(col, match, false), nil
+	case sqlparser.NotBetweenStr:
+		return newColumnFilter(col, match, true), nil
+	default:
+		return nil, query.NewConverterError(
+			"%s: range condition operator must be 'between' or 'not between'",
+			query.InvalidExpressionErrMessage,
+		)
+	}
+}
+
+func (c *queryConverter) convertIsExpr(expr *sqlparser.IsExpr) (recordFilter, error) {
+	col, err := c.convertColName(expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.IsNullStr:
+		return func(record *queryRecord) bool { return len(record.values(col.name)) == 0 }, nil
+	case sqlparser.IsNotNullStr:
+		return func(record *queryRecord) bool { return len(record.values(col.name)) != 0 }, nil
+	default:
+		return nil, query.NewConverterError(
+			"%s: 'IS' operator can only be used with 'NULL' or 'NOT NULL'",
+			query.InvalidExpressionErrMessage,
+		)
+	}
+}
+
+func (c *queryConverter) convertColName(expr sqlparser.Expr) (*column, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, query.NewConverterError(
+			"%s: must be a column name but was %T",
+			query.InvalidExpressionErrMessage,
+			expr,
+		)
+	}
+	alias := strings.ReplaceAll(sqlparser.String(colName), "`", "")
+	name := alias
+	if alias == searchattribute.ScheduleID && !c.saTypeMap.IsDefined(alias) {
+		// ScheduleId is a fake search attribute -- convert to WorkflowId
+		name = searchattribute.WorkflowID
+	}
+	valueType, err := c.saTypeMap.GetType(name)
+	if err != nil {
+		return nil, query.NewConverterError(
+			"%s: column name '%s' is not a valid search attribute",
+			query.InvalidExpressionErrMessage,
+			alias,
+		)
+	}
+	if name == searchattribute.TemporalNamespaceDivision {
+		c.seenNamespaceDivision = true
+	}
+	return &column{
+		alias:     alias,
+		name:      name,
+		valueType: valueType,
+	}, nil
+}
+
+func (c *queryConverter) convertSingleValueExpr(expr sqlparser.Expr, col *column) (interface{}, error) {
+	if _, isTuple := expr.(sqlparser.ValTuple); isTuple {
+		return nil, query.NewConverterError(
+			"%s: expected a single value for search attribute %s",
+			query.InvalidExpressionErrMessage,
+			col.alias,
+		)
+	}
+	values, err := c.convertValueExpr(expr, col)
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// convertValueExpr returns the values of the expression converted to the type of the column:
+// string, int64, float64, bool or time.Time.
+func (c *queryConverter) convertValueExpr(expr sqlparser.Expr, col *column) ([]interface{}, error) {
+	switch e := expr.(type) {
+	case *sqlparser.SQLVal:
+		value, err := c.parseSQLVal(e, col)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{value}, nil
+	case sqlparser.BoolVal:
+		if col.valueType != enumspb.INDEXED_VALUE_TYPE_BOOL {
+			return nil, query.NewConverterError(
+				"%s: unexpected value type %T for search attribute %s",
+				query.InvalidExpressionErrMessage,
+				e,
+				col.alias,
+			)
+		}
+		return []interface{}{bool(e)}, nil
+	case sqlparser.ValTuple:
+		// This is "in (1,2,3)" case.
+		var result []interface{}
+		for _, valueExpr := range e {
+			values, err := c.convertValueExpr(valueExpr, col)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, values...)
+		}
+		return result, nil
+	case *sqlparser.GroupConcatExpr:
+		return nil, query.NewConverterError("%s: 'group_concat'", query.NotSupportedErrMessage)
+	case *sqlparser.FuncExpr:
+		return nil, query.NewConverterError("%s: nested func", query.NotSupportedErrMessage)
+	case *sqlparser.ColName:
+		return nil, query.NewConverterError(
+			"%s: column name on the right side of comparison expression (did you forget to quote '%s'?)",
+			query.NotSupportedErrMessage,
+			sqlparser.String(expr),
+		)
+	default:
+		return nil, query.NewConverterError(
+			"%s: unexpected value type %T",
+			query.InvalidExpressionErrMessage,
+			expr,
+		)
+	}
+}
+
+// parseSQLVal handles values for specific search attributes.
+// For datetime, converts to time.Time in UTC.
+// For execution status, converts string to enum value.
+// For execution duration, converts to nanoseconds.
+func (c *queryConverter) parseSQLVal(expr *sqlparser.SQLVal, col *column) (interface{}, error) {
+	var sqlValue string
+	switch expr.Type {
+	case sqlparser.StrVal:
+		sqlValue = fmt.Sprintf(`'%s'`, expr.Val)
+	default:
+		sqlValue = string(expr.Val)
+	}
+	value, err := query.ParseSqlValue(sqlValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if col.alias == searchattribute.ScheduleID && col.name == searchattribute.WorkflowID {
+		return primitives.ScheduleWorkflowIDPrefix + fmt.Sprintf("%v", value), nil
+	}
+
+	switch col.name {
+	case searchattribute.ExecutionStatus:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case string:
+			code, err := enumspb.WorkflowExecutionStatusFromString(v)
+			if err != nil {
+				return nil, query.NewConverterError(
+					"%s: invalid ExecutionStatus value '%s'",
+					query.InvalidExpressionErrMessage,
+					v,
+				)
+			}
+			return int64(code), nil
+		}
+	case searchattribute.ExecutionDuration:
+		if durationStr, isString := value.(string); isString {
+			duration, err := query.ParseExecutionDurationStr(durationStr)
+			if err != nil {
+				return nil, query.NewConverterError(
+					"invalid value for search attribute %s: %v (%v)", col.alias, value, err)
+			}
+			return duration.Nanoseconds(), nil
+		}
+	}
+
+	switch col.valueType {
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		switch v := value.(type) {
+		case int64:
+			return time.Unix(0, v).UTC(), nil
+		case string:
+			tm, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, query.NewConverterError(
+					"%s: unable to parse datetime '%s'",
+					query.InvalidExpressionErrMessage,
+					v,
+				)
+			}
+			return tm.UTC(), nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		switch v := value.(type) {
+		case int64, float64:
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		if v, isString := value.(string); isString {
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b, nil
+			}
+		}
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		enumspb.INDEXED_VALUE_TYPE_TEXT:
+		return fmt.Sprintf("%v", value), nil
+	}
+
+	return nil, query.NewConverterError(
+		"%s: unexpected value type %T for search attribute %s",
+		query.InvalidExpressionErrMessage,
+		value,
+		col.alias,
+	)
+}
+
+// narrowCloseTimeRange extracts the CloseTime bounds implied by the top level conjunction of
+// the query. They are used to skip partitions and files which cannot contain matching records.
+func (c *queryConverter) narrowCloseTimeRange(expr sqlparser.Expr, parsedQuery *ParsedQuery) {
+	narrow := func(earliest, latest interface{}) {
+		if t, ok := earliest.(time.Time); ok && t.After(parsedQuery.earliestCloseTime) {
+			parsedQuery.earliestCloseTime = t
+		}
+		if t, ok := latest.(time.Time); ok && t.Before(parsedQuery.latestCloseTime) {
+			parsedQuery.latestCloseTime = t
+		}
+	}
+	isCloseTime := func(expr sqlparser.Expr) (*column, bool) {
+		col, err := c.convertColName(expr)
+		return col, err == nil && col.name == searchattribute.CloseTime
+	}
+
+	switch e := expr.(type) {
+	case *sqlparser.ParenExpr:
+		c.narrowCloseTimeRange(e.Expr, parsedQuery)
+	case *sqlparser.AndExpr:
+		c.narrowCloseTimeRange(e.Left, parsedQuery)
+		c.narrowCloseTimeRange(e.Right, parsedQuery)
+	case *sqlparser.ComparisonExpr:
+		col, ok := isCloseTime(e.Left)
+		if !ok {
+			return
+		}
+		value, err := c.convertSingleValueExpr(e.Right, col)
+		if err != nil {
+			return
+		}
+		switch e.Operator {
+		case sqlparser.EqualStr:
+			narrow(value, value)
+		case sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+			narrow(value, nil)
+		case sqlparser.LessThanStr, sqlparser.LessEqualStr:
+			narrow(nil, value)
+		}
+	case *sqlparser.RangeCond:
+		col, ok := isCloseTime(e.Left)
+		if !ok || e.Operator != sqlparser.BetweenStr {
+			return
+		}
+		from, err := c.convertSingleValueExpr(e.From, col)
+		if err != nil {
+			return
+		}
+		to, err := c.convertSingleValueExpr(e.To, col)
+		if err != nil {
+			return
+		}
+		narrow(from, to)
+	}
+}
+
+// newColumnFilter returns a filter which matches records with at least one value of the column
+// satisfying match. If negate is set the filter matches all other records, including the ones
+// without any value for the column, like a 'must not' query of the Elasticsearch visibility store.
+func newColumnFilter(col *column, match func(v interface{}) bool, negate bool) recordFilter {
+	return func(record *queryRecord) bool {
+		matched := false
+		for _, v := range record.values(col.name) {
+			if match(v) {
+				matched = true
+				break
+			}
+		}
+		return matched != negate
+	}
+}
+
+func newQueryRecord(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) *queryRecord {
+	// Search attributes which can't be decoded are treated as if they were not set.
+	searchAttributes, _ := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
+	decoded, _ := searchattribute.Decode(searchAttributes, &saTypeMap, true)
+	return &queryRecord{
+		VisibilityRecord: record,
+		searchAttributes: decoded,
+	}
+}
+
+// values returns all values of a search attribute of the record in the types produced by parseSQLVal.
+func (r *queryRecord) values(name string) []interface{} {
+	switch name {
+	case searchattribute.WorkflowID:
+		return []interface{}{r.GetWorkflowId()}
+	case searchattribute.RunID:
+		return []interface{}{r.GetRunId()}
+	case searchattribute.WorkflowType:
+		return []interface{}{r.GetWorkflowTypeName()}
+	case searchattribute.StartTime:
+		if r.StartTime == nil {
+			return nil
+		}
+		return []interface{}{r.StartTime.AsTime()}
+	case searchattribute.ExecutionTime:
+		if r.ExecutionTime == nil {
+			return nil
+		}
+		return []interface{}{r.ExecutionTime.AsTime()}
+	case searchattribute.CloseTime:
+		if r.CloseTime == nil {
+			return nil
+		}
+		return []interface{}{r.CloseTime.AsTime()}
+	case searchattribute.ExecutionStatus:
+		return []interface{}{int64(r.GetStatus())}
+	case searchattribute.HistoryLength:
+		return []interface{}{r.GetHistoryLength()}
+	case searchattribute.ExecutionDuration:
+		if r.ExecutionDuration == nil {
+			return nil
+		}
+		return []interface{}{r.ExecutionDuration.AsDuration().Nanoseconds()}
+	}
+
+	switch v := r.searchAttributes[name].(type) {
+	case nil:
+		return nil
+	case []string:
+		return toInterfaceSlice(v)
+	case []int64:
+		return toInterfaceSlice(v)
+	case []float64:
+		return toInterfaceSlice(v)
+	case []bool:
+		return toInterfaceSlice(v)
+	case []time.Time:
+		return toInterfaceSlice(v)
+	default:
+		return []interface{}{v}
+	}
+}
+
+func toInterfaceSlice[T any](values []T) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
+
+// compare returns the ordering of a and b, and false if they are not comparable.
+func compare(a, b interface{}) (int, bool) {
+	switch av := a.(type) {
+	case string:
+		bv, ok := b.(string)
+		return strings.Compare(av, bv), ok
+	case bool:
+		bv, ok := b.(bool)
+		if !ok || av == bv {
+			return 0, ok
+		}
+		if !av {
+			return -1, true
+		}
+		return 1, true
+	case time.Time:
+		bv, ok := b.(time.Time)
+		return av.Compare(bv), ok
+	case int64:
+		switch bv := b.(type) {
+		case int64:
+			return compareOrdered(av, bv), true
+		case float64:
+			return compareOrdered(float64(av), bv), true
+		}
+	case float64:
+		switch bv := b.(type) {
+		case int64:
+			return compareOrdered(av, float64(bv)), true
+		case float64:
+			return compareOrdered(av, bv), true
+		}
+	}
+	return 0, false
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareEqual(a, b interface{}) bool {
+	cmp, ok := compare(a, b)
+	return ok && cmp == 0
+}
+
+func compareMatches(a, b interface{}, predicate func(cmp int) bool) bool {
+	cmp, ok := compare(a, b)
+	return ok && predicate(cmp)
+}
+
+// textMatch mimics a full text match: it reports whether any term of the query value appears in v.
+func textMatch(v, queryValue interface{}) bool {
+	text, ok := v.(string)
+	if !ok {
+		return false
+	}
+	queryText, ok := queryValue.(string)
+	if !ok {
+		return false
+	}
+	terms := make(map[string]struct{})
+	for _, term := range tokenize(text) {
+		terms[term] = struct{}{}
+	}
+	for _, term := range tokenize(queryText) {
+		if _, ok := terms[term]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+func isSupportedOperator(supportedOperators []string, operator string) bool {
+	for _, op := range supportedOperators {
+		if operator == op {
+			return true
+		}
+	}
+	return false
+}
+
+func isSupportedTypeRangeCond(saType enumspb.IndexedValueType) bool {
+	for _, tp := range supportedTypesRangeCond {
+		if saType == tp {
+			return true
+		}
+	}
+	return false
+}
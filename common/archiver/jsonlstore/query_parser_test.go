@@ -0,0 +1,203 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package jsonlstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type queryParserSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	parser QueryParser
+	record *archiverspb.VisibilityRecord
+}
+
+func TestQueryParserSuite(t *testing.T) {
+	suite.Run(t, new(queryParserSuite))
+}
+
+func (s *queryParserSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.parser = NewQueryParser()
+	s.record = &archiverspb.VisibilityRecord{
+		NamespaceId:       testNamespaceID,
+		Namespace:         testNamespace,
+		WorkflowId:        "order-workflow-1",
+		RunId:             testRunID,
+		WorkflowTypeName:  "OrderWorkflow",
+		StartTime:         timestamppb.New(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+		ExecutionTime:     timestamppb.New(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+		CloseTime:         timestamppb.New(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)),
+		ExecutionDuration: durationpb.New(2 * time.Hour),
+		Status:            enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		HistoryLength:     42,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField":  "gold",
+			"CustomTextField":     "Shipped to the Berlin warehouse",
+			"CustomIntField":      "7",
+			"CustomDoubleField":   "1.5",
+			"CustomBoolField":     "true",
+			"CustomDatetimeField": "2024-02-28T00:00:00Z",
+			"KeywordList01":       `["red","green"]`,
+		},
+	}
+}
+
+func (s *queryParserSuite) TestParse_Match() {
+	testCases := []struct {
+		query    string
+		expected bool
+	}{
+		{query: "", expected: true},
+		{query: "WorkflowId = 'order-workflow-1'", expected: true},
+		{query: "WorkflowId != 'order-workflow-1'", expected: false},
+		{query: "WorkflowId STARTS_WITH 'order-'", expected: true},
+		{query: "WorkflowId NOT STARTS_WITH 'order-'", expected: false},
+		{query: "WorkflowType IN ('PaymentWorkflow', 'OrderWorkflow')", expected: true},
+		{query: "WorkflowType NOT IN ('PaymentWorkflow', 'OrderWorkflow')", expected: false},
+		{query: "RunId = 'other-run-id'", expected: false},
+		{query: "ExecutionStatus = 'Completed'", expected: true},
+		{query: "ExecutionStatus = 2", expected: true},
+		{query: "ExecutionStatus != 'Failed'", expected: true},
+		{query: "CloseTime >= '2024-03-01T00:00:00Z' AND CloseTime < '2024-03-02T00:00:00Z'", expected: true},
+		{query: "CloseTime BETWEEN '2024-03-02T00:00:00Z' AND '2024-03-03T00:00:00Z'", expected: false},
+		{query: "CloseTime NOT BETWEEN '2024-03-02T00:00:00Z' AND '2024-03-03T00:00:00Z'", expected: true},
+		{query: "ExecutionDuration > '1h'", expected: true},
+		{query: "ExecutionDuration > '3h'", expected: false},
+		{query: "HistoryLength BETWEEN 40 AND 50", expected: true},
+		{query: "CustomKeywordField = 'gold'", expected: true},
+		{query: "CustomKeywordField IS NOT NULL", expected: true},
+		{query: "Keyword01 IS NULL", expected: true},
+		{query: "Keyword01 != 'x'", expected: true},
+		{query: "CustomTextField = 'berlin'", expected: true},
+		{query: "CustomTextField = 'munich'", expected: false},
+		{query: "CustomIntField >= 7 AND CustomDoubleField < 2", expected: true},
+		{query: "CustomDoubleField > 1", expected: true},
+		{query: "CustomBoolField = true", expected: true},
+		{query: "CustomBoolField = false", expected: false},
+		{query: "CustomDatetimeField < '2024-03-01T00:00:00Z'", expected: true},
+		{query: "KeywordList01 = 'green'", expected: true},
+		{query: "KeywordList01 IN ('blue', 'red')", expected: true},
+		{query: "KeywordList01 NOT IN ('blue', 'red')", expected: false},
+		{query: "(CustomIntField = 1 OR CustomKeywordField = 'gold') AND WorkflowType = 'OrderWorkflow'", expected: true},
+		{query: "NOT (CustomIntField = 7)", expected: false},
+		{query: "TemporalNamespaceDivision IS NULL", expected: true},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
+		s.NoError(err, tc.query)
+		s.Equal(tc.expected, parsedQuery.filter(newQueryRecord(s.record, searchattribute.TestNameTypeMap)), tc.query)
+	}
+}
+
+func (s *queryParserSuite) TestParse_NamespaceDivision() {
+	s.record.SearchAttributes[searchattribute.TemporalNamespaceDivision] = "TemporalScheduler"
+	record := newQueryRecord(s.record, searchattribute.TestNameTypeMap)
+
+	parsedQuery, err := s.parser.Parse("WorkflowType = 'OrderWorkflow'", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.False(parsedQuery.filter(record))
+
+	parsedQuery, err = s.parser.Parse("TemporalNamespaceDivision = 'TemporalScheduler'", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.True(parsedQuery.filter(record))
+}
+
+func (s *queryParserSuite) TestParse_ScheduleID() {
+	s.record.WorkflowId = primitives.ScheduleWorkflowIDPrefix + "my-schedule"
+	parsedQuery, err := s.parser.Parse("ScheduleId = 'my-schedule'", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.True(parsedQuery.filter(newQueryRecord(s.record, searchattribute.TestNameTypeMap)))
+}
+
+func (s *queryParserSuite) TestParse_CloseTimeRange() {
+	parsedQuery, err := s.parser.Parse(
+		"CloseTime > '2024-03-01T00:00:00Z' AND (CloseTime <= '2024-03-05T00:00:00Z' AND WorkflowId = 'abc')",
+		searchattribute.TestNameTypeMap,
+	)
+	s.NoError(err)
+	s.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), parsedQuery.earliestCloseTime)
+	s.Equal(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), parsedQuery.latestCloseTime)
+	s.False(parsedQuery.emptyResult)
+
+	// OR expressions can't be used to narrow the range
+	parsedQuery, err = s.parser.Parse(
+		"CloseTime > '2024-03-01T00:00:00Z' OR WorkflowId = 'abc'",
+		searchattribute.TestNameTypeMap,
+	)
+	s.NoError(err)
+	s.True(parsedQuery.earliestCloseTime.IsZero())
+	s.Equal(maxCloseTime, parsedQuery.latestCloseTime)
+
+	parsedQuery, err = s.parser.Parse(
+		"CloseTime BETWEEN '2024-03-05T00:00:00Z' AND '2024-03-01T00:00:00Z'",
+		searchattribute.TestNameTypeMap,
+	)
+	s.NoError(err)
+	s.True(parsedQuery.emptyResult)
+}
+
+func (s *queryParserSuite) TestParse_Error() {
+	testCases := []string{
+		"WorkflowId = ",
+		"UnknownField = 'abc'",
+		"WorkflowId = 'abc' ORDER BY CloseTime",
+		"WorkflowId = 'abc' GROUP BY ExecutionStatus",
+		"WorkflowId = 'abc' LIMIT 10",
+		"WorkflowId LIKE 'abc%'",
+		"WorkflowId IN 'abc'",
+		"WorkflowId = ('a', 'b')",
+		"WorkflowId",
+		"StartTime < CloseTime",
+		"ExecutionStatus = 'Unknown'",
+		"CloseTime > 'yesterday'",
+		"ExecutionDuration > 'long'",
+		"CustomIntField = 'seven'",
+		"CustomBoolField BETWEEN true AND false",
+		"CustomTextField STARTS_WITH 'abc'",
+		"KeywordList01 > 'abc'",
+		"CustomKeywordField STARTS_WITH 123",
+		"lower(WorkflowId) = 'abc'",
+	}
+
+	for _, query := range testCases {
+		_, err := s.parser.Parse(query, searchattribute.TestNameTypeMap)
+		s.Error(err, query)
+	}
+}
@@ -0,0 +1,374 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package jsonlstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	historypb "go.temporal.io/api/history/v1"
+	"go.uber.org/multierr"
+
+	"go.temporal.io/server/common/archiver"
+)
+
+const (
+	compressionGzip = "gzip"
+	compressionNone = "none"
+
+	jsonlExtension = ".jsonl"
+	gzipExtension  = ".gz"
+
+	namespacePartitionKey = "namespace_id"
+	closeDatePartitionKey = "close_date"
+	closeDateFormat       = "2006-01-02"
+)
+
+var (
+	errDirectoryExpected  = errors.New("a path to a directory was expected")
+	errFileExpected       = errors.New("a path to a file was expected")
+	errEmptyDirectoryPath = errors.New("directory path is empty")
+	errInvalidCompression = errors.New("invalid compression, must be one of: gzip, none")
+)
+
+// File I/O util
+
+func fileExists(filepath string) (bool, error) {
+	if info, err := os.Stat(filepath); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	} else if info.IsDir() {
+		return false, errFileExpected
+	}
+	return true, nil
+}
+
+func directoryExists(path string) (bool, error) {
+	if info, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	} else if !info.IsDir() {
+		return false, errDirectoryExpected
+	}
+	return true, nil
+}
+
+func mkdirAll(path string, dirMode os.FileMode) error {
+	return os.MkdirAll(path, dirMode)
+}
+
+// writeFile writes data to a temporary file in the same directory and renames it into place,
+// so readers scanning a partition never observe a partially written file.
+func writeFile(filepath string, data []byte, fileMode os.FileMode) (retErr error) {
+	f, err := os.CreateTemp(path.Dir(filepath), "."+path.Base(filepath)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			_ = os.Remove(f.Name())
+		}
+	}()
+	if err = f.Chmod(fileMode); err != nil {
+		return multierr.Combine(err, f.Close())
+	}
+	if _, err = f.Write(data); err != nil {
+		return multierr.Combine(err, f.Close())
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath)
+}
+
+// readFile reads the contents of a file specified by filepath
+// WARNING: callers of this method should be extremely careful not to use it in a context where filepath is supplied by
+// the user.
+func readFile(filepath string) ([]byte, error) {
+	// #nosec
+	return os.ReadFile(filepath)
+}
+
+func listDirEntries(dirPath string, wantDir bool) (names []string, err error) {
+	if info, err := os.Stat(dirPath); err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		return nil, errDirectoryExpected
+	}
+
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		// skip temporary files of in-flight writes
+		if entry.IsDir() != wantDir || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	return names, nil
+}
+
+func listFiles(dirPath string) ([]string, error) {
+	return listDirEntries(dirPath, false)
+}
+
+func listDirectories(dirPath string) ([]string, error) {
+	return listDirEntries(dirPath, true)
+}
+
+func listFilesByPrefix(dirPath string, prefix string) ([]string, error) {
+	fileNames, err := listFiles(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredFileNames []string
+	for _, name := range fileNames {
+		if strings.HasPrefix(name, prefix) {
+			filteredFileNames = append(filteredFileNames, name)
+		}
+	}
+	return filteredFileNames, nil
+}
+
+// findFile returns the path of the file with the given base name, regardless of the
+// compression it was written with. An empty string is returned if no such file exists.
+func findFile(dirPath string, basename string) (string, error) {
+	for _, name := range []string{basename + gzipExtension, basename} {
+		filepath := path.Join(dirPath, name)
+		exists, err := fileExists(filepath)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return filepath, nil
+		}
+	}
+	return "", nil
+}
+
+// encoding & decoding util
+
+func validateCompression(compression string) (string, error) {
+	switch compression {
+	case "", compressionGzip:
+		return compressionGzip, nil
+	case compressionNone:
+		return compressionNone, nil
+	default:
+		return "", errInvalidCompression
+	}
+}
+
+func fileExtension(compression string) string {
+	if compression == compressionGzip {
+		return jsonlExtension + gzipExtension
+	}
+	return jsonlExtension
+}
+
+// encodeRows encodes each row as a single line of JSON and compresses the result if required.
+func encodeRows[T any](rows []T, compression string) ([]byte, error) {
+	var buf bytes.Buffer
+	var w io.Writer = &buf
+	var gz *gzip.Writer
+	if compression == compressionGzip {
+		gz = gzip.NewWriter(&buf)
+		w = gz
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return nil, err
+		}
+	}
+
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeRows decodes JSON lines, decompressing data first if it is gzipped.
+func decodeRows[T any](data []byte) (rows []T, retErr error) {
+	var r io.Reader = bytes.NewReader(data)
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			retErr = multierr.Combine(retErr, gz.Close())
+		}()
+		r = gz
+	}
+
+	decoder := json.NewDecoder(r)
+	for decoder.More() {
+		var row T
+		if err := decoder.Decode(&row); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
+	token := &queryVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// Path construction
+
+func constructPartitionName(key string, value string) string {
+	return key + "=" + value
+}
+
+func constructNamespaceDirPath(rootPath string, namespaceID string) string {
+	return path.Join(rootPath, constructPartitionName(namespacePartitionKey, namespaceID))
+}
+
+func constructCloseDatePartition(closeTime time.Time) string {
+	return constructPartitionName(closeDatePartitionKey, closeTime.UTC().Format(closeDateFormat))
+}
+
+func parseCloseDatePartition(name string) (time.Time, error) {
+	value, found := strings.CutPrefix(name, closeDatePartitionKey+"=")
+	if !found {
+		return time.Time{}, fmt.Errorf("failed to parse close date partition %s", name)
+	}
+	return time.Parse(closeDateFormat, value)
+}
+
+// constructHistoryBasename returns the name of a history file without the extension.
+func constructHistoryBasename(namespaceID, workflowID, runID string, version int64) string {
+	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
+	return fmt.Sprintf("%s_%v.history%s", combinedHash, version, jsonlExtension)
+}
+
+func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) string {
+	return strings.Join([]string{hash(namespaceID), hash(workflowID), hash(runID)}, "")
+}
+
+func constructVisibilityFilename(closeTimestamp time.Time, runID string, compression string) string {
+	return fmt.Sprintf("%v_%s.visibility%s", closeTimestamp.UnixNano(), hash(runID), fileExtension(compression))
+}
+
+func hash(s string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
+}
+
+// Validation
+
+func validateDirPath(dirPath string) error {
+	if len(dirPath) == 0 {
+		return errEmptyDirectoryPath
+	}
+	info, err := os.Stat(dirPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return errDirectoryExpected
+	}
+	return nil
+}
+
+// Misc.
+
+// splitFilename splits a file name of the format <first>_<second>.<kind>.jsonl[.gz]
+// into its first two parts.
+func splitFilename(filename string) (string, string, error) {
+	stem, _, found := strings.Cut(filename, ".")
+	if !found {
+		return "", "", errors.New("unknown filename structure")
+	}
+	first, second, found := strings.Cut(stem, "_")
+	if !found {
+		return "", "", errors.New("unknown filename structure")
+	}
+	return first, second, nil
+}
+
+func extractCloseFailoverVersion(filename string) (int64, error) {
+	_, version, err := splitFilename(filename)
+	if err != nil {
+		return -1, err
+	}
+	return strconv.ParseInt(version, 10, 64)
+}
+
+func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*historypb.History, isLast bool) bool {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+	lastFailoverVersion := lastEvent.GetVersion()
+	if lastFailoverVersion > request.CloseFailoverVersion {
+		return true
+	}
+
+	if !isLast {
+		return false
+	}
+	lastEventID := lastEvent.GetEventId()
+	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
+}
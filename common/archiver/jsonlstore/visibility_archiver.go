@@ -0,0 +1,507 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package jsonlstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		fileMode    os.FileMode
+		dirMode     os.FileMode
+		compression string
+		queryParser QueryParser
+	}
+
+	queryVisibilityToken struct {
+		LastCloseTime time.Time
+		LastRunID     string
+	}
+
+	queryVisibilityRequest struct {
+		namespaceID   string
+		pageSize      int
+		nextPageToken []byte
+		parsedQuery   *ParsedQuery
+	}
+
+	// visibilityRow is the single line of an archived visibility file.
+	visibilityRow struct {
+		NamespaceID        string            `json:"namespace_id"`
+		Namespace          string            `json:"namespace"`
+		WorkflowID         string            `json:"workflow_id"`
+		RunID              string            `json:"run_id"`
+		WorkflowTypeName   string            `json:"workflow_type_name"`
+		StartTime          *time.Time        `json:"start_time,omitempty"`
+		ExecutionTime      *time.Time        `json:"execution_time,omitempty"`
+		CloseTime          time.Time         `json:"close_time"`
+		Status             string            `json:"status"`
+		HistoryLength      int64             `json:"history_length"`
+		ExecutionDuration  *int64            `json:"execution_duration_ns,omitempty"`
+		HistoryArchivalURI string            `json:"history_archival_uri,omitempty"`
+		SearchAttributes   map[string]string `json:"search_attributes,omitempty"`
+		Memo               json.RawMessage   `json:"memo,omitempty"`
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on jsonlstore
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.JsonlstoreArchiver,
+) (archiver.VisibilityArchiver, error) {
+	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(config.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	compression, err := validateCompression(config.Compression)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityArchiver{
+		container:   container,
+		fileMode:    os.FileMode(fileMode),
+		dirMode:     os.FileMode(dirMode),
+		compression: compression,
+		queryParser: NewQueryParser(),
+	}, nil
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiverspb.VisibilityRecord,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && featureCatalog.NonRetryableError != nil {
+			err = featureCatalog.NonRetryableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	encodedVisibilityRecord, err := encodeVisibilityRecord(request, v.compression)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	closeTime := request.CloseTime.AsTime()
+	dirPath := path.Join(constructNamespaceDirPath(URI.Path(), request.GetNamespaceId()), constructCloseDatePartition(closeTime))
+	if err = mkdirAll(dirPath, v.dirMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
+	}
+
+	// The filename has the format: closeTimestamp_hash(runID).visibility.jsonl[.gz]
+	// This format allows the archiver to sort all records without reading the file contents
+	filename := constructVisibilityFilename(closeTime, request.GetRunId(), v.compression)
+	if err := writeFile(path.Join(dirPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query, saTypeMap)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	return v.query(
+		ctx,
+		URI,
+		&queryVisibilityRequest{
+			namespaceID:   request.NamespaceID,
+			pageSize:      request.PageSize,
+			nextPageToken: request.NextPageToken,
+			parsedQuery:   parsedQuery,
+		},
+		saTypeMap,
+	)
+}
+
+func (v *visibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	var token *queryVisibilityToken
+	if request.nextPageToken != nil {
+		var err error
+		token, err = deserializeQueryVisibilityToken(request.nextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	dirPath := constructNamespaceDirPath(URI.Path(), request.namespaceID)
+	exists, err := directoryExists(dirPath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if !exists {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	partitions, err := listDirectories(dirPath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	partitions, err = sortAndFilterPartitions(partitions, request.parsedQuery, token)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, partition := range partitions {
+		partitionPath := path.Join(dirPath, partition)
+		files, err := listFiles(partitionPath)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		files, err = sortAndFilterFiles(files, token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+
+		for _, file := range files {
+			encodedRecord, err := readFile(path.Join(partitionPath, file))
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+
+			closeTime := record.CloseTime.AsTime()
+			if closeTime.Before(request.parsedQuery.earliestCloseTime) {
+				return response, nil
+			}
+			if closeTime.After(request.parsedQuery.latestCloseTime) {
+				continue
+			}
+
+			if !request.parsedQuery.filter(newQueryRecord(record, saTypeMap)) {
+				continue
+			}
+
+			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+
+			response.Executions = append(response.Executions, executionInfo)
+			if len(response.Executions) == request.pageSize {
+				newToken := &queryVisibilityToken{
+					LastCloseTime: timestamp.TimeValue(record.CloseTime),
+					LastRunID:     record.GetRunId(),
+				}
+				encodedToken, err := serializeToken(newToken)
+				if err != nil {
+					return nil, serviceerror.NewInternal(err.Error())
+				}
+				response.NextPageToken = encodedToken
+				return response, nil
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	return validateDirPath(URI.Path())
+}
+
+type parsedPartition struct {
+	name      string
+	closeDate time.Time
+}
+
+// sortAndFilterPartitions sorts close date partitions (desc) and drops the ones which
+// can't contain records within the close time range of the query or after the nextPageToken.
+func sortAndFilterPartitions(partitions []string, query *ParsedQuery, token *queryVisibilityToken) ([]string, error) {
+	truncateToDate := func(t time.Time) time.Time {
+		return t.UTC().Truncate(24 * time.Hour)
+	}
+	earliestDate := truncateToDate(query.earliestCloseTime)
+	latestDate := truncateToDate(query.latestCloseTime)
+	if token != nil && token.LastCloseTime.Before(query.latestCloseTime) {
+		latestDate = truncateToDate(token.LastCloseTime)
+	}
+
+	var parsedPartitions []*parsedPartition
+	for _, name := range partitions {
+		closeDate, err := parseCloseDatePartition(name)
+		if err != nil {
+			return nil, err
+		}
+		if closeDate.Before(earliestDate) || closeDate.After(latestDate) {
+			continue
+		}
+		parsedPartitions = append(parsedPartitions, &parsedPartition{
+			name:      name,
+			closeDate: closeDate,
+		})
+	}
+
+	sort.Slice(parsedPartitions, func(i, j int) bool {
+		return parsedPartitions[i].closeDate.After(parsedPartitions[j].closeDate)
+	})
+
+	filteredPartitions := make([]string, 0, len(parsedPartitions))
+	for _, parsedPartition := range parsedPartitions {
+		filteredPartitions = append(filteredPartitions, parsedPartition.name)
+	}
+	return filteredPartitions, nil
+}
+
+type parsedVisFilename struct {
+	name        string
+	closeTime   time.Time
+	hashedRunID string
+}
+
+// sortAndFilterFiles sort visibility record file names based on close timestamp (desc) and use hashed runID to break ties.
+// if a nextPageToken is give, it only returns filenames that have a smaller close timestamp
+func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]string, error) {
+	var parsedFilenames []*parsedVisFilename
+	for _, name := range filenames {
+		closeTimeStr, hashedRunID, err := splitFilename(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+		}
+
+		closeTime, err := strconv.ParseInt(closeTimeStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+		}
+		parsedFilenames = append(parsedFilenames, &parsedVisFilename{
+			name:        name,
+			closeTime:   timestamp.UnixOrZeroTime(closeTime),
+			hashedRunID: hashedRunID,
+		})
+	}
+
+	sort.Slice(parsedFilenames, func(i, j int) bool {
+		if parsedFilenames[i].closeTime.Equal(parsedFilenames[j].closeTime) {
+			return parsedFilenames[i].hashedRunID > parsedFilenames[j].hashedRunID
+		}
+		return parsedFilenames[i].closeTime.After(parsedFilenames[j].closeTime)
+	})
+
+	startIdx := 0
+	if token != nil {
+		LastHashedRunID := hash(token.LastRunID)
+		startIdx = sort.Search(len(parsedFilenames), func(i int) bool {
+			if parsedFilenames[i].closeTime.Equal(token.LastCloseTime) {
+				return parsedFilenames[i].hashedRunID < LastHashedRunID
+			}
+			return parsedFilenames[i].closeTime.Before(token.LastCloseTime)
+		})
+	}
+
+	if startIdx == len(parsedFilenames) {
+		return []string{}, nil
+	}
+
+	var filteredFilenames []string
+	for _, parsedFilename := range parsedFilenames[startIdx:] {
+		filteredFilenames = append(filteredFilenames, parsedFilename.name)
+	}
+	return filteredFilenames, nil
+}
+
+func encodeVisibilityRecord(record *archiverspb.VisibilityRecord, compression string) ([]byte, error) {
+	row := &visibilityRow{
+		NamespaceID:        record.GetNamespaceId(),
+		Namespace:          record.GetNamespace(),
+		WorkflowID:         record.GetWorkflowId(),
+		RunID:              record.GetRunId(),
+		WorkflowTypeName:   record.GetWorkflowTypeName(),
+		CloseTime:          record.GetCloseTime().AsTime(),
+		Status:             record.GetStatus().String(),
+		HistoryLength:      record.GetHistoryLength(),
+		HistoryArchivalURI: record.GetHistoryArchivalUri(),
+		SearchAttributes:   record.GetSearchAttributes(),
+		StartTime:          timestamp.TimeValuePtr(record.StartTime),
+		ExecutionTime:      timestamp.TimeValuePtr(record.ExecutionTime),
+	}
+	if record.ExecutionDuration != nil {
+		executionDuration := record.ExecutionDuration.AsDuration().Nanoseconds()
+		row.ExecutionDuration = &executionDuration
+	}
+	if record.Memo != nil {
+		encoder := codec.NewJSONPBEncoder()
+		memo, err := encoder.Encode(record.Memo)
+		if err != nil {
+			return nil, err
+		}
+		row.Memo = memo
+	}
+	return encodeRows([]*visibilityRow{row}, compression)
+}
+
+func decodeVisibilityRecord(data []byte) (*archiverspb.VisibilityRecord, error) {
+	rows, err := decodeRows[*visibilityRow](data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) != 1 {
+		return nil, fmt.Errorf("expected a single visibility record, found %d", len(rows))
+	}
+	row := rows[0]
+
+	status, err := enumspb.WorkflowExecutionStatusFromString(row.Status)
+	if err != nil {
+		return nil, err
+	}
+	record := &archiverspb.VisibilityRecord{
+		NamespaceId:        row.NamespaceID,
+		Namespace:          row.Namespace,
+		WorkflowId:         row.WorkflowID,
+		RunId:              row.RunID,
+		WorkflowTypeName:   row.WorkflowTypeName,
+		CloseTime:          timestamppb.New(row.CloseTime),
+		Status:             status,
+		HistoryLength:      row.HistoryLength,
+		HistoryArchivalUri: row.HistoryArchivalURI,
+		SearchAttributes:   row.SearchAttributes,
+	}
+	if row.StartTime != nil {
+		record.StartTime = timestamppb.New(*row.StartTime)
+	}
+	if row.ExecutionTime != nil {
+		record.ExecutionTime = timestamppb.New(*row.ExecutionTime)
+	}
+	if row.ExecutionDuration != nil {
+		record.ExecutionDuration = durationpb.New(time.Duration(*row.ExecutionDuration))
+	}
+	if len(row.Memo) != 0 {
+		memo := &commonpb.Memo{}
+		encoder := codec.NewJSONPBEncoder()
+		if err := encoder.Decode(row.Memo, memo); err != nil {
+			return nil, err
+		}
+		record.Memo = memo
+	}
+	return record, nil
+}
+
+func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
+	searchAttributes, err := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: record.GetWorkflowId(),
+			RunId:      record.GetRunId(),
+		},
+		Type: &commonpb.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:         record.StartTime,
+		ExecutionTime:     record.ExecutionTime,
+		CloseTime:         record.CloseTime,
+		ExecutionDuration: record.ExecutionDuration,
+		Status:            record.Status,
+		HistoryLength:     record.HistoryLength,
+		Memo:              record.Memo,
+		SearchAttributes:  searchAttributes,
+	}, nil
+}
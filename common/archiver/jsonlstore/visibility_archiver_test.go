@@ -0,0 +1,351 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package jsonlstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/tests/testutils"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container          *archiver.VisibilityBootstrapContainer
+	testQueryDirectory string
+	testQueryURI       archiver.URI
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupSuite() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger: log.NewNoopLogger(),
+	}
+	var err error
+	s.testQueryDirectory, err = os.MkdirTemp("", "TestQuery")
+	s.Require().NoError(err)
+	s.testQueryURI, err = archiver.NewURI(URIScheme + "://" + s.testQueryDirectory)
+	s.Require().NoError(err)
+	s.setupVisibilityDirectory()
+}
+
+func (s *visibilityArchiverSuite) TearDownSuite() {
+	if err := os.RemoveAll(s.testQueryDirectory); err != nil {
+		s.Fail("Failed to remove test query directory %v: %v", s.testQueryDirectory, err)
+	}
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger: log.NewNoopLogger(),
+	}
+}
+
+func (s *visibilityArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "file:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "jsonl://",
+			expectedErr: errEmptyDirectoryPath,
+		},
+		{
+			URI:         "jsonl:///a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver(compressionGzip)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, visibilityArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver(compressionGzip)
+	err := visibilityArchiver.Archive(context.Background(), s.testQueryURI, &archiverspb.VisibilityRecord{})
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_NonRetryableErrorOption() {
+	visibilityArchiver := s.newTestVisibilityArchiver(compressionGzip)
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := visibilityArchiver.Archive(
+		context.Background(),
+		s.testQueryURI,
+		&archiverspb.VisibilityRecord{},
+		archiver.GetNonRetryableErrorOption(nonRetryableErr),
+	)
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	for _, compression := range []string{compressionGzip, compressionNone} {
+		s.Run(compression, func() {
+			dir := testutils.MkdirTemp(s.T(), "", "TestArchiveSuccess")
+			URI, err := archiver.NewURI(URIScheme + "://" + dir)
+			s.NoError(err)
+
+			memo, err := payload.Encode("memo value")
+			s.NoError(err)
+			closeTime := time.Date(2024, 3, 1, 23, 59, 59, 123, time.UTC)
+			request := &archiverspb.VisibilityRecord{
+				NamespaceId:        testNamespaceID,
+				Namespace:          testNamespace,
+				WorkflowId:         testWorkflowID,
+				RunId:              testRunID,
+				WorkflowTypeName:   "test-workflow-type",
+				StartTime:          timestamppb.New(closeTime.Add(-time.Hour)),
+				ExecutionTime:      nil, // workflow without backoff
+				CloseTime:          timestamppb.New(closeTime),
+				ExecutionDuration:  durationpb.New(time.Hour),
+				Status:             enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+				HistoryLength:      int64(101),
+				Memo:               &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": memo}},
+				SearchAttributes:   map[string]string{"CustomKeywordField": "value"},
+				HistoryArchivalUri: "jsonl:///history/archival/uri",
+			}
+
+			visibilityArchiver := s.newTestVisibilityArchiver(compression)
+			s.NoError(visibilityArchiver.Archive(context.Background(), URI, request))
+
+			filepath := path.Join(
+				constructNamespaceDirPath(dir, testNamespaceID),
+				"close_date=2024-03-01",
+				constructVisibilityFilename(closeTime, testRunID, compression),
+			)
+			data, err := readFile(filepath)
+			s.NoError(err)
+			record, err := decodeVisibilityRecord(data)
+			s.NoError(err)
+			protorequire.ProtoEqual(s.T(), request, record)
+		})
+	}
+}
+
+func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
+	filenames := []string{"9_12345.visibility.jsonl.gz", "5_0.visibility.jsonl", "5_1.visibility.jsonl.gz", "20_9.visibility.jsonl"}
+	sorted, err := sortAndFilterFiles(filenames, nil)
+	s.NoError(err)
+	s.Equal([]string{"20_9.visibility.jsonl", "9_12345.visibility.jsonl.gz", "5_1.visibility.jsonl.gz", "5_0.visibility.jsonl"}, sorted)
+
+	_, err = sortAndFilterFiles([]string{"invalid.visibility.jsonl"}, nil)
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver(compressionGzip)
+
+	URI, err := archiver.NewURI("file:///a/b/c")
+	s.NoError(err)
+	_, err = visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+	}, searchattribute.TestNameTypeMap)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = visibilityArchiver.Query(context.Background(), s.testQueryURI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+	}, searchattribute.TestNameTypeMap)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = visibilityArchiver.Query(context.Background(), s.testQueryURI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "UnknownField = 'abc'",
+	}, searchattribute.TestNameTypeMap)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = visibilityArchiver.Query(context.Background(), s.testQueryURI, &archiver.QueryVisibilityRequest{
+		NamespaceID:   testNamespaceID,
+		PageSize:      1,
+		NextPageToken: []byte{'r', 'a', 'n', 'd', 'o', 'm'},
+	}, searchattribute.TestNameTypeMap)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
+	visibilityArchiver := s.newTestVisibilityArchiver(compressionGzip)
+	response, err := visibilityArchiver.Query(context.Background(), s.testQueryURI, &archiver.QueryVisibilityRequest{
+		NamespaceID: "some-other-namespace-id",
+		PageSize:    1,
+	}, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Empty(response.Executions)
+	s.Nil(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success() {
+	testCases := []struct {
+		query         string
+		expectedRunID []string
+	}{
+		{
+			query:         "",
+			expectedRunID: []string{"run-5", "run-4", "run-3", "run-2", "run-1", "run-0"},
+		},
+		{
+			query:         "ExecutionStatus = 'Completed'",
+			expectedRunID: []string{"run-4", "run-2", "run-0"},
+		},
+		{
+			query:         "CloseTime >= '2024-03-02T00:00:00Z' AND CloseTime < '2024-03-04T00:00:00Z'",
+			expectedRunID: []string{"run-2", "run-1"},
+		},
+		{
+			query:         "CustomIntField >= 3 OR CustomKeywordField = 'even'",
+			expectedRunID: []string{"run-5", "run-4", "run-3", "run-2", "run-0"},
+		},
+		{
+			query:         "WorkflowType = 'type-1' AND CustomKeywordField != 'even'",
+			expectedRunID: []string{"run-5", "run-3", "run-1"},
+		},
+		{
+			query:         "WorkflowId STARTS_WITH 'workflow-' AND CustomIntField BETWEEN 1 AND 2",
+			expectedRunID: []string{"run-2", "run-1"},
+		},
+		{
+			query:         "CloseTime < '2024-01-01T00:00:00Z'",
+			expectedRunID: nil,
+		},
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver(compressionGzip)
+	for _, tc := range testCases {
+		response, err := visibilityArchiver.Query(context.Background(), s.testQueryURI, &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    testPageSize,
+			Query:       tc.query,
+		}, searchattribute.TestNameTypeMap)
+		s.NoError(err, tc.query)
+		s.Nil(response.NextPageToken, tc.query)
+		var runIDs []string
+		for _, execution := range response.Executions {
+			runIDs = append(runIDs, execution.GetExecution().GetRunId())
+		}
+		s.Equal(tc.expectedRunID, runIDs, tc.query)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_Pagination() {
+	visibilityArchiver := s.newTestVisibilityArchiver(compressionGzip)
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    2,
+		Query:       "CustomIntField != 3",
+	}
+
+	var runIDs []string
+	for {
+		response, err := visibilityArchiver.Query(context.Background(), s.testQueryURI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		s.LessOrEqual(len(response.Executions), request.PageSize)
+		for _, execution := range response.Executions {
+			runIDs = append(runIDs, execution.GetExecution().GetRunId())
+		}
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]string{"run-5", "run-4", "run-2", "run-1", "run-0"}, runIDs)
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver(compression string) *visibilityArchiver {
+	config := &config.JsonlstoreArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: compression,
+	}
+	archiver, err := NewVisibilityArchiver(s.container, config)
+	s.NoError(err)
+	return archiver.(*visibilityArchiver)
+}
+
+// setupVisibilityDirectory archives one record per day, starting from 2024-03-01.
+// Even records are completed workflows of type-0, odd records failed workflows of type-1.
+func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
+	visibilityArchiver := s.newTestVisibilityArchiver(compressionGzip)
+	startDate := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 6; i++ {
+		closeTime := startDate.Add(time.Duration(i) * 24 * time.Hour)
+		status := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+		keyword := "even"
+		if i%2 == 1 {
+			status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+			keyword = "odd"
+		}
+		record := &archiverspb.VisibilityRecord{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       fmt.Sprintf("workflow-%d", i),
+			RunId:            fmt.Sprintf("run-%d", i),
+			WorkflowTypeName: fmt.Sprintf("type-%d", i%2),
+			StartTime:        timestamppb.New(closeTime.Add(-time.Minute)),
+			CloseTime:        timestamppb.New(closeTime),
+			Status:           status,
+			HistoryLength:    int64(i),
+			SearchAttributes: map[string]string{
+				"CustomIntField":     fmt.Sprintf("%d", i),
+				"CustomKeywordField": keyword,
+			},
+		}
+		s.Require().NoError(visibilityArchiver.Archive(context.Background(), s.testQueryURI, record))
+	}
+}
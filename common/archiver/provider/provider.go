@@ -34,6 +34,7 @@ import (
 
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/archiver/jsonlstore"
 	"go.temporal.io/server/common/archiver/s3store"
 	"go.temporal.io/server/common/config"
 )
@@ -157,6 +158,11 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 			return nil, ErrArchiverConfigNotFound
 		}
 		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
+	case jsonlstore.URIScheme:
+		if p.historyArchiverConfigs.Jsonlstore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		historyArchiver, err = jsonlstore.NewHistoryArchiver(container, p.historyArchiverConfigs.Jsonlstore)
 	default:
 		return nil, ErrUnknownScheme
 	}
@@ -207,6 +213,11 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 			return nil, ErrArchiverConfigNotFound
 		}
 		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
+	case jsonlstore.URIScheme:
+		if p.visibilityArchiverConfigs.Jsonlstore == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = jsonlstore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Jsonlstore)
 
 	default:
 		return nil, ErrUnknownScheme
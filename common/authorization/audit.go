@@ -0,0 +1,334 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/proxy"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// AuditRecord describes an authorization decision for an API call
+	AuditRecord struct {
+		Time       time.Time       `json:"time"`
+		Subject    string          `json:"subject,omitempty"`
+		API        string          `json:"api"`
+		Namespace  string          `json:"namespace,omitempty"`
+		WorkflowID string          `json:"workflowId,omitempty"`
+		RunID      string          `json:"runId,omitempty"`
+		Decision   string          `json:"decision"`
+		Reason     string          `json:"reason,omitempty"`
+		Request    json.RawMessage `json:"request,omitempty"`
+	}
+
+	// AuditSink records authorization decisions
+	AuditSink interface {
+		Record(record *AuditRecord) error
+		Close() error
+	}
+
+	// Auditor samples authorization decisions, redacts the requests and writes them to an AuditSink
+	Auditor struct {
+		sink           AuditSink
+		sampleRates    map[string]float64
+		includeRequest bool
+		redactFields   map[protoreflect.Name]struct{}
+	}
+
+	// jsonAuditSink writes audit records as JSON lines. Records are queued and written in the
+	// background, so that a slow disk or pipe doesn't slow down API calls. Records that don't
+	// fit in the queue are dropped.
+	jsonAuditSink struct {
+		encoder        *json.Encoder
+		closer         io.Closer
+		records        chan *AuditRecord
+		done           chan struct{}
+		metricsHandler metrics.Handler
+		logger         log.Logger
+
+		// protects records from being closed while Record sends to it
+		lock   sync.RWMutex
+		closed bool
+	}
+
+	hasWorkflowID interface {
+		GetWorkflowId() string
+	}
+)
+
+var _ AuditSink = (*jsonAuditSink)(nil)
+
+var errAuditSinkClosed = errors.New("audit sink is closed")
+
+const defaultAuditQueueSize = 10000
+
+// NewStdoutAuditSink creates an AuditSink that writes JSON lines to stdout.
+func NewStdoutAuditSink(queueSize int, metricsHandler metrics.Handler, logger log.Logger) AuditSink {
+	return newJSONAuditSink(os.Stdout, nil, queueSize, metricsHandler, logger)
+}
+
+// NewFileAuditSink creates an AuditSink that appends JSON lines to a file.
+func NewFileAuditSink(path string, queueSize int, metricsHandler metrics.Handler, logger log.Logger) (AuditSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit log %s: %w", path, err)
+	}
+	return newJSONAuditSink(file, file, queueSize, metricsHandler, logger), nil
+}
+
+func newJSONAuditSink(
+	w io.Writer,
+	closer io.Closer,
+	queueSize int,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *jsonAuditSink {
+	if queueSize <= 0 {
+		queueSize = defaultAuditQueueSize
+	}
+	s := &jsonAuditSink{
+		encoder:        json.NewEncoder(w),
+		closer:         closer,
+		records:        make(chan *AuditRecord, queueSize),
+		done:           make(chan struct{}),
+		metricsHandler: metricsHandler.WithTags(metrics.OperationTag(metrics.AuthorizationScope)),
+		logger:         logger,
+	}
+	go s.writeLoop()
+	return s
+}
+
+// Record queues a record for writing. It never blocks: if the queue is full, the record is
+// dropped and counted in the service_authorization_audit_dropped metric.
+func (s *jsonAuditSink) Record(record *AuditRecord) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.closed {
+		return errAuditSinkClosed
+	}
+	select {
+	case s.records <- record:
+	default:
+		metrics.ServiceAuthorizationAuditDropped.With(s.metricsHandler).Record(1)
+	}
+	return nil
+}
+
+func (s *jsonAuditSink) writeLoop() {
+	defer close(s.done)
+	for record := range s.records {
+		if err := s.encoder.Encode(record); err != nil {
+			metrics.ServiceAuthorizationAuditFailures.With(s.metricsHandler).Record(1)
+			s.logger.Error("Unable to write authorization audit record", tag.Error(err))
+		}
+	}
+}
+
+// Close writes the queued records and closes the underlying file, if any.
+func (s *jsonAuditSink) Close() error {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.records)
+	s.lock.Unlock()
+
+	<-s.done
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+// GetAuditorFromConfig creates an Auditor with the sink configured in cfg.
+// Returns nil if audit logging is disabled.
+func GetAuditorFromConfig(
+	cfg *config.AuthorizationAudit,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) (*Auditor, error) {
+	var sink AuditSink
+	switch strings.ToLower(cfg.Sink) {
+	case "":
+		return nil, nil
+	case "stdout":
+		sink = NewStdoutAuditSink(cfg.QueueSize, metricsHandler, logger)
+	case "file":
+		if cfg.File == "" {
+			return nil, fmt.Errorf("no file configured for the audit log")
+		}
+		var err error
+		if sink, err = NewFileAuditSink(cfg.File, cfg.QueueSize, metricsHandler, logger); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown audit sink: %s", cfg.Sink)
+	}
+	return NewAuditor(sink, cfg)
+}
+
+// NewAuditor creates an Auditor that writes to the given sink, with the sampling
+// and redaction settings of cfg.
+func NewAuditor(sink AuditSink, cfg *config.AuthorizationAudit) (*Auditor, error) {
+	for api, rate := range cfg.SampleRates {
+		if rate < 0 || rate > 1 {
+			return nil, fmt.Errorf("audit sample rate for %s must be between 0 and 1: %v", api, rate)
+		}
+	}
+	redactFields := make(map[protoreflect.Name]struct{}, len(cfg.RedactFields))
+	for _, field := range cfg.RedactFields {
+		redactFields[protoreflect.Name(field)] = struct{}{}
+	}
+	return &Auditor{
+		sink:           sink,
+		sampleRates:    cfg.SampleRates,
+		includeRequest: cfg.IncludeRequest,
+		redactFields:   redactFields,
+	}, nil
+}
+
+// Close closes the sink of the auditor.
+func (a *Auditor) Close() error {
+	return a.sink.Close()
+}
+
+// Audit records a decision for a call, subject to sampling.
+func (a *Auditor) Audit(ctx context.Context, claims *Claims, target *CallTarget, decision Decision, reason string) error {
+	if decision == DecisionAllow && !a.sampled(target.APIName) {
+		return nil
+	}
+	record := &AuditRecord{
+		Time:      time.Now().UTC(),
+		API:       target.APIName,
+		Namespace: target.Namespace,
+		Decision:  decision.String(),
+		Reason:    reason,
+	}
+	if claims != nil {
+		record.Subject = claims.Subject
+	}
+	record.WorkflowID, record.RunID = auditWorkflowExecution(target.Request)
+	if a.includeRequest {
+		if request, ok := target.Request.(proto.Message); ok {
+			redacted, err := a.redact(ctx, request)
+			if err != nil {
+				return err
+			}
+			record.Request = redacted
+		}
+	}
+	return a.sink.Record(record)
+}
+
+func (a *Auditor) sampled(api string) bool {
+	rate, ok := a.sampleRates[api]
+	if !ok {
+		rate, ok = a.sampleRates[api[strings.LastIndex(api, "/")+1:]]
+	}
+	return !ok || rand.Float64() < rate
+}
+
+// redact returns the JSON encoding of a copy of the request without payloads and redacted fields.
+func (a *Auditor) redact(ctx context.Context, request proto.Message) (json.RawMessage, error) {
+	request = proto.Clone(request)
+	err := proxy.VisitPayloads(ctx, request, proxy.VisitPayloadsOptions{
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			redacted := make([]*commonpb.Payload, len(payloads))
+			for i := range redacted {
+				redacted[i] = &commonpb.Payload{}
+			}
+			return redacted, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(a.redactFields) > 0 {
+		a.clearFields(request.ProtoReflect())
+	}
+	return protojson.Marshal(request)
+}
+
+func (a *Auditor) clearFields(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if _, ok := a.redactFields[fd.Name()]; ok {
+			m.Clear(fd)
+			return true
+		}
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				a.clearFields(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				a.clearFields(mv.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsMap():
+			a.clearFields(v.Message())
+		}
+		return true
+	})
+}
+
+// auditWorkflowExecution returns the workflow ID and run ID targeted by a request, if any.
+func auditWorkflowExecution(request interface{}) (string, string) {
+	var execution *commonpb.WorkflowExecution
+	if r, ok := request.(hasWorkflowExecution); ok {
+		execution = r.GetWorkflowExecution()
+	} else if r, ok := request.(hasExecution); ok {
+		execution = r.GetExecution()
+	}
+	if execution != nil {
+		return execution.GetWorkflowId(), execution.GetRunId()
+	}
+	if r, ok := request.(hasWorkflowID); ok {
+		return r.GetWorkflowId(), ""
+	}
+	return "", ""
+}
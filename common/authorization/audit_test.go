@@ -0,0 +1,246 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+type (
+	auditSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		sink *testAuditSink
+	}
+
+	testAuditSink struct {
+		records []*AuditRecord
+		closed  bool
+	}
+)
+
+const (
+	terminateAPI = "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution"
+	pollAPI      = "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue"
+)
+
+func TestAuditSuite(t *testing.T) {
+	s := new(auditSuite)
+	suite.Run(t, s)
+}
+
+func (s *auditSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.sink = &testAuditSink{}
+}
+
+func (s *testAuditSink) Record(record *AuditRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *testAuditSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func (s *auditSuite) TestRecord() {
+	auditor, err := NewAuditor(s.sink, &config.AuthorizationAudit{})
+	s.NoError(err)
+
+	request := &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace:         testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+	}
+	target := &CallTarget{APIName: terminateAPI, Namespace: testNamespace, Request: request}
+	err = auditor.Audit(context.Background(), &Claims{Subject: "alice"}, target, DecisionAllow, "")
+	s.NoError(err)
+	err = auditor.Audit(context.Background(), nil, target, DecisionDeny, "no role")
+	s.NoError(err)
+
+	s.Len(s.sink.records, 2)
+	s.Equal("alice", s.sink.records[0].Subject)
+	s.Equal(terminateAPI, s.sink.records[0].API)
+	s.Equal(testNamespace, s.sink.records[0].Namespace)
+	s.Equal("wid", s.sink.records[0].WorkflowID)
+	s.Equal("rid", s.sink.records[0].RunID)
+	s.Equal("allow", s.sink.records[0].Decision)
+	s.Nil(s.sink.records[0].Request)
+	s.Equal("", s.sink.records[1].Subject)
+	s.Equal("deny", s.sink.records[1].Decision)
+	s.Equal("no role", s.sink.records[1].Reason)
+
+	s.NoError(auditor.Close())
+	s.True(s.sink.closed)
+}
+
+func (s *auditSuite) TestWorkflowID() {
+	workflowID, runID := auditWorkflowExecution(&workflowservice.StartWorkflowExecutionRequest{WorkflowId: "wid"})
+	s.Equal("wid", workflowID)
+	s.Equal("", runID)
+	workflowID, runID = auditWorkflowExecution(&workflowservice.DescribeNamespaceRequest{})
+	s.Equal("", workflowID)
+	s.Equal("", runID)
+}
+
+func (s *auditSuite) TestSampling() {
+	auditor, err := NewAuditor(s.sink, &config.AuthorizationAudit{
+		SampleRates: map[string]float64{"PollWorkflowTaskQueue": 0},
+	})
+	s.NoError(err)
+
+	poll := &CallTarget{APIName: pollAPI, Request: &workflowservice.PollWorkflowTaskQueueRequest{}}
+	terminate := &CallTarget{APIName: terminateAPI, Request: &workflowservice.TerminateWorkflowExecutionRequest{}}
+	s.NoError(auditor.Audit(context.Background(), nil, poll, DecisionAllow, ""))
+	s.NoError(auditor.Audit(context.Background(), nil, terminate, DecisionAllow, ""))
+	s.NoError(auditor.Audit(context.Background(), nil, poll, DecisionDeny, ""))
+
+	s.Len(s.sink.records, 2)
+	s.Equal(terminateAPI, s.sink.records[0].API)
+	s.Equal(pollAPI, s.sink.records[1].API)
+	s.Equal("deny", s.sink.records[1].Decision)
+
+	_, err = NewAuditor(s.sink, &config.AuthorizationAudit{SampleRates: map[string]float64{pollAPI: 2}})
+	s.Error(err)
+}
+
+func (s *auditSuite) TestRedaction() {
+	auditor, err := NewAuditor(s.sink, &config.AuthorizationAudit{
+		IncludeRequest: true,
+		RedactFields:   []string{"identity"},
+	})
+	s.NoError(err)
+
+	request := &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace:         testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wid"},
+		SignalName:        "approve",
+		Input:             &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: []byte("secret")}}},
+		Identity:          "worker@host",
+	}
+	target := &CallTarget{APIName: "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution", Request: request}
+	s.NoError(auditor.Audit(context.Background(), nil, target, DecisionAllow, ""))
+
+	s.Len(s.sink.records, 1)
+	var recorded map[string]interface{}
+	s.NoError(json.Unmarshal(s.sink.records[0].Request, &recorded))
+	s.Equal("approve", recorded["signalName"])
+	s.Equal(map[string]interface{}{"payloads": []interface{}{map[string]interface{}{}}}, recorded["input"])
+	s.NotContains(recorded, "identity")
+	// the request handled by the server is unchanged
+	s.Equal([]byte("secret"), request.Input.Payloads[0].Data)
+	s.Equal("worker@host", request.Identity)
+}
+
+func (s *auditSuite) TestFileSink() {
+	path := filepath.Join(s.T().TempDir(), "audit.log")
+	auditor, err := GetAuditorFromConfig(&config.AuthorizationAudit{Sink: "file", File: path}, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	s.NoError(err)
+
+	target := &CallTarget{APIName: terminateAPI, Namespace: testNamespace}
+	s.NoError(auditor.Audit(context.Background(), &Claims{Subject: "alice"}, target, DecisionAllow, ""))
+	s.NoError(auditor.Audit(context.Background(), &Claims{Subject: "bob"}, target, DecisionDeny, ""))
+	s.NoError(auditor.Close())
+
+	file, err := os.Open(path)
+	s.NoError(err)
+	defer func() { _ = file.Close() }()
+	var subjects []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record AuditRecord
+		s.NoError(json.Unmarshal(scanner.Bytes(), &record))
+		subjects = append(subjects, record.Subject)
+	}
+	s.Equal([]string{"alice", "bob"}, subjects)
+}
+
+func (s *auditSuite) TestGetAuditorFromConfig() {
+	auditor, err := GetAuditorFromConfig(&config.AuthorizationAudit{}, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	s.NoError(err)
+	s.Nil(auditor)
+
+	auditor, err = GetAuditorFromConfig(&config.AuthorizationAudit{Sink: "stdout"}, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	s.NoError(err)
+	s.NotNil(auditor)
+
+	s.NoError(auditor.Close())
+
+	_, err = GetAuditorFromConfig(&config.AuthorizationAudit{Sink: "file"}, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	s.Error(err)
+	_, err = GetAuditorFromConfig(&config.AuthorizationAudit{Sink: "kafka"}, metrics.NoopMetricsHandler, log.NewNoopLogger())
+	s.Error(err)
+}
+
+func (s *auditSuite) TestJSONSinkOverflow() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+
+	writer := &blockingWriter{unblock: make(chan struct{}), written: make(chan struct{}, 10)}
+	sink := newJSONAuditSink(writer, nil, 1, metricsHandler, log.NewNoopLogger())
+
+	// the first record is being written, the second one is queued and the third one is dropped
+	s.NoError(sink.Record(&AuditRecord{Subject: "alice"}))
+	<-writer.written
+	s.NoError(sink.Record(&AuditRecord{Subject: "bob"}))
+	s.NoError(sink.Record(&AuditRecord{Subject: "carol"}))
+	s.Len(capture.Snapshot()[metrics.ServiceAuthorizationAuditDropped.Name()], 1)
+
+	close(writer.unblock)
+	s.NoError(sink.Close())
+	s.Equal(2, strings.Count(writer.buffer.String(), "\n"))
+	s.ErrorIs(sink.Record(&AuditRecord{}), errAuditSinkClosed)
+}
+
+type blockingWriter struct {
+	buffer  bytes.Buffer
+	unblock chan struct{}
+	written chan struct{}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	w.written <- struct{}{}
+	<-w.unblock
+	return w.buffer.Write(p)
+}
@@ -31,7 +31,11 @@ import (
 	"fmt"
 	"strings"
 
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 const (
@@ -43,7 +47,6 @@ const (
 
 // @@@SNIPSTART temporal-common-authorization-authorizer-calltarget
 // CallTarget is contains information for Authorizer to make a decision.
-// It can be extended to include resources like WorkflowType and TaskQueue
 type CallTarget struct {
 	// APIName must be the full API function name.
 	// Example: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution".
@@ -54,10 +57,62 @@ type CallTarget struct {
 	NexusEndpointName string
 	// Request contains a deserialized copy of the API request object
 	Request interface{}
+	// The workflow type targeted by the request, if the request contains it.
+	// See ResolveWorkflowType for requests that target an existing workflow execution.
+	WorkflowType string
+	// The task queue targeted by the request, if the request contains it.
+	TaskQueue string
+
+	// looks up the workflow type of the targeted workflow execution (if any)
+	workflowTypeLookup func(ctx context.Context) (string, error)
+	// the type of the existing execution targeted by a request that also contains a workflow
+	// type, set by ResolveWorkflowTypes
+	existingWorkflowType string
 }
 
 // @@@SNIPEND
 
+// ResolveWorkflowType returns the workflow type targeted by the request. For requests that target an
+// existing workflow execution and don't contain the workflow type, like SignalWorkflowExecution, the
+// type is looked up if the Interceptor has a WorkflowTypeResolver. This is more expensive than using
+// WorkflowType, so authorizers should only call it when they need the type. Returns an empty string
+// if the workflow type is unknown.
+func (ct *CallTarget) ResolveWorkflowType(ctx context.Context) (string, error) {
+	if ct.WorkflowType == "" && ct.workflowTypeLookup != nil {
+		workflowType, err := ct.workflowTypeLookup(ctx)
+		if err != nil {
+			return "", err
+		}
+		ct.WorkflowType = workflowType
+		ct.workflowTypeLookup = nil
+	}
+	return ct.WorkflowType, nil
+}
+
+// ResolveWorkflowTypes returns all workflow types targeted by the request. This is the result of
+// ResolveWorkflowType, plus the type of the existing execution for requests that contain a
+// workflow type but can also act on an existing execution of another type, like
+// SignalWithStartWorkflowExecution. Authorizers that check workflow types should use it instead
+// of ResolveWorkflowType.
+func (ct *CallTarget) ResolveWorkflowTypes(ctx context.Context) ([]string, error) {
+	if ct.WorkflowType != "" && ct.workflowTypeLookup != nil {
+		existingWorkflowType, err := ct.workflowTypeLookup(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ct.existingWorkflowType = existingWorkflowType
+		ct.workflowTypeLookup = nil
+	}
+	workflowType, err := ct.ResolveWorkflowType(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ct.existingWorkflowType != "" && ct.existingWorkflowType != workflowType {
+		return []string{workflowType, ct.existingWorkflowType}, nil
+	}
+	return []string{workflowType}, nil
+}
+
 type (
 	// Result is result from authority.
 	Result struct {
@@ -70,6 +125,17 @@ type (
 	Decision int
 )
 
+func (d Decision) String() string {
+	switch d {
+	case DecisionAllow:
+		return "allow"
+	case DecisionDeny:
+		return "deny"
+	default:
+		return "unknown"
+	}
+}
+
 // @@@SNIPSTART temporal-common-authorization-authorizer-interface
 // Authorizer is an interface for implementing authorization logic
 type Authorizer interface {
@@ -82,13 +148,31 @@ type hasNamespace interface {
 	GetNamespace() string
 }
 
-func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+type hasWorkflowType interface {
+	GetWorkflowType() *commonpb.WorkflowType
+}
+
+type hasTaskQueue interface {
+	GetTaskQueue() *taskqueuepb.TaskQueue
+}
+
+type hasWorkflowExecution interface {
+	GetWorkflowExecution() *commonpb.WorkflowExecution
+}
+
+type hasExecution interface {
+	GetExecution() *commonpb.WorkflowExecution
+}
+
+func GetAuthorizerFromConfig(config *config.Authorization, logger log.Logger) (Authorizer, error) {
 
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		return NewPolicyAuthorizer(&config.Policy, logger)
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
@@ -0,0 +1,246 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	spiffeScheme = "spiffe"
+	// by default a wildcard of a rule pattern can't capture the separators of URIs and email
+	// addresses, so that it matches within a single path segment. Dots are allowed since
+	// namespace names often contain them.
+	defaultWildcardSeparators = "/:@"
+)
+
+type (
+	// Claim mapper that maps the identities in mTLS client certificates to roles
+	certificateClaimMapper struct {
+		subjectField string
+		rules        []certificateClaimRule
+		logger       log.Logger
+	}
+
+	certificateClaimRule struct {
+		field       string
+		pattern     *regexp.Regexp
+		permissions []certificatePermission
+	}
+
+	certificatePermission struct {
+		// namespace template that may reference the wildcards of the rule pattern
+		namespace string
+		// set if namespace references the wildcards of the rule pattern
+		templated bool
+		role      Role
+	}
+
+	certificateFieldGetter func(subject *pkix.Name, cert *x509.Certificate) []string
+)
+
+var _ ClaimMapper = (*certificateClaimMapper)(nil)
+
+// certificateFields are the certificate fields that rules can match, keyed by lower case name.
+// cert is nil if only the subject of the client certificate is known.
+var certificateFields = map[string]certificateFieldGetter{
+	"commonname": func(subject *pkix.Name, _ *x509.Certificate) []string {
+		if subject.CommonName == "" {
+			return nil
+		}
+		return []string{subject.CommonName}
+	},
+	"organization": func(subject *pkix.Name, _ *x509.Certificate) []string {
+		return subject.Organization
+	},
+	"organizationalunit": func(subject *pkix.Name, _ *x509.Certificate) []string {
+		return subject.OrganizationalUnit
+	},
+	"dnsname": func(_ *pkix.Name, cert *x509.Certificate) []string {
+		if cert == nil {
+			return nil
+		}
+		return cert.DNSNames
+	},
+	"emailaddress": func(_ *pkix.Name, cert *x509.Certificate) []string {
+		if cert == nil {
+			return nil
+		}
+		return cert.EmailAddresses
+	},
+	"uri": func(_ *pkix.Name, cert *x509.Certificate) []string {
+		if cert == nil {
+			return nil
+		}
+		uris := make([]string, 0, len(cert.URIs))
+		for _, uri := range cert.URIs {
+			uris = append(uris, uri.String())
+		}
+		return uris
+	},
+	"spiffeid": func(_ *pkix.Name, cert *x509.Certificate) []string {
+		if cert == nil {
+			return nil
+		}
+		var ids []string
+		for _, uri := range cert.URIs {
+			if strings.EqualFold(uri.Scheme, spiffeScheme) {
+				ids = append(ids, uri.String())
+			}
+		}
+		return ids
+	},
+}
+
+func NewCertificateClaimMapper(cfg *config.CertificateClaimMapper, logger log.Logger) (ClaimMapper, error) {
+	mapper := &certificateClaimMapper{
+		subjectField: strings.ToLower(cfg.SubjectField),
+		logger:       logger,
+	}
+	if _, ok := certificateFields[mapper.subjectField]; mapper.subjectField != "" && !ok {
+		return nil, fmt.Errorf("unknown certificate subject field: %s", cfg.SubjectField)
+	}
+	for i, rule := range cfg.Rules {
+		compiled, err := compileCertificateClaimRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("certificate claim mapper rule %d: %w", i, err)
+		}
+		mapper.rules = append(mapper.rules, compiled)
+	}
+	return mapper, nil
+}
+
+func compileCertificateClaimRule(rule config.CertificateClaimRule) (certificateClaimRule, error) {
+	field := strings.ToLower(rule.Field)
+	if _, ok := certificateFields[field]; !ok {
+		return certificateClaimRule{}, fmt.Errorf("unknown certificate field: %s", rule.Field)
+	}
+	if rule.Pattern == "" {
+		return certificateClaimRule{}, fmt.Errorf("empty pattern")
+	}
+	parts := strings.Split(rule.Pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	separators := defaultWildcardSeparators
+	if rule.WildcardSeparators != nil {
+		separators = *rule.WildcardSeparators
+	}
+	wildcard := "(.*)"
+	if separators != "" {
+		var class strings.Builder
+		for _, r := range separators {
+			_, _ = fmt.Fprintf(&class, `\x{%x}`, r)
+		}
+		wildcard = "([^" + class.String() + "]*)"
+	}
+	pattern, err := regexp.Compile("^" + strings.Join(parts, wildcard) + "$")
+	if err != nil {
+		return certificateClaimRule{}, err
+	}
+	compiled := certificateClaimRule{field: field, pattern: pattern}
+	for _, permission := range rule.Permissions {
+		i := strings.LastIndex(permission, ":")
+		if i <= 0 {
+			return certificateClaimRule{}, fmt.Errorf("invalid permission %q", permission)
+		}
+		role := permissionToRole(permission[i+1:])
+		if role == RoleUndefined {
+			return certificateClaimRule{}, fmt.Errorf("invalid permission %q", permission)
+		}
+		compiled.permissions = append(compiled.permissions, certificatePermission{
+			namespace: permission[:i],
+			templated: strings.Contains(permission[:i], "$"),
+			role:      role,
+		})
+	}
+	return compiled, nil
+}
+
+func (m *certificateClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims := Claims{}
+	if authInfo == nil {
+		return &claims, nil
+	}
+	cert := PeerCert(authInfo.TLSConnection)
+	subject := authInfo.TLSSubject
+	if subject == nil && cert != nil {
+		subject = &cert.Subject
+	}
+	if subject == nil {
+		return &claims, nil
+	}
+
+	claims.Subject = m.subject(subject, cert)
+	for _, rule := range m.rules {
+		for _, value := range certificateFields[rule.field](subject, cert) {
+			match := rule.pattern.FindStringSubmatchIndex(value)
+			if match == nil {
+				continue
+			}
+			for _, permission := range rule.permissions {
+				namespace := string(rule.pattern.ExpandString(nil, permission.namespace, value, match))
+				if namespace == "" {
+					m.logger.Warn(fmt.Sprintf("ignoring permission with empty namespace for certificate %s: %s", rule.field, value))
+					continue
+				}
+				if namespace == permissionScopeSystem {
+					if permission.templated {
+						// system permissions are only granted by rules that name the system namespace literally
+						m.logger.Warn(fmt.Sprintf("ignoring templated system permission for certificate %s: %s", rule.field, value))
+						continue
+					}
+					claims.System |= permission.role
+					continue
+				}
+				if claims.Namespaces == nil {
+					claims.Namespaces = make(map[string]Role)
+				}
+				claims.Namespaces[namespace] |= permission.role
+			}
+		}
+	}
+	return &claims, nil
+}
+
+func (m *certificateClaimMapper) subject(subject *pkix.Name, cert *x509.Certificate) string {
+	if m.subjectField != "" {
+		if values := certificateFields[m.subjectField](subject, cert); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	if ids := certificateFields["spiffeid"](subject, cert); len(ids) > 0 {
+		return ids[0]
+	}
+	return subject.CommonName
+}
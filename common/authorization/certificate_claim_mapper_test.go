@@ -0,0 +1,211 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/credentials"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	certificateClaimMapperSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestCertificateClaimMapperSuite(t *testing.T) {
+	s := new(certificateClaimMapperSuite)
+	suite.Run(t, s)
+}
+
+func (s *certificateClaimMapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *certificateClaimMapperSuite) newClaimMapper(cfg config.CertificateClaimMapper) ClaimMapper {
+	claimMapper, err := GetClaimMapperFromConfig(&config.Authorization{
+		ClaimMapper:            "certificate",
+		CertificateClaimMapper: cfg,
+	}, log.NewNoopLogger())
+	s.NoError(err)
+	return claimMapper
+}
+
+func certificateAuthInfo(cert *x509.Certificate) *AuthInfo {
+	return &AuthInfo{
+		TLSSubject: &cert.Subject,
+		TLSConnection: &credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	}
+}
+
+func (s *certificateClaimMapperSuite) TestSubjectFields() {
+	claimMapper := s.newClaimMapper(config.CertificateClaimMapper{
+		Rules: []config.CertificateClaimRule{
+			{Field: "organizationalUnit", Pattern: "ops", Permissions: []string{primitives.SystemLocalNamespace + ":admin"}},
+			{Field: "commonName", Pattern: "*.workers.example.com", Permissions: []string{"$1:worker", "$1:write"}},
+			{Field: "organization", Pattern: "Other", Permissions: []string{"default:read"}},
+		},
+	})
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{
+		TLSSubject: &pkix.Name{
+			CommonName:         "payments.workers.example.com",
+			OrganizationalUnit: []string{"dev", "ops"},
+		},
+	})
+	s.NoError(err)
+	s.Equal("payments.workers.example.com", claims.Subject)
+	s.Equal(RoleAdmin, claims.System)
+	s.Equal(map[string]Role{"payments": RoleWorker | RoleWriter}, claims.Namespaces)
+}
+
+func (s *certificateClaimMapperSuite) TestSANs() {
+	claimMapper := s.newClaimMapper(config.CertificateClaimMapper{
+		Rules: []config.CertificateClaimRule{
+			{Field: "spiffeID", Pattern: "spiffe://example.org/ns/*/*", Permissions: []string{"$1:read"}},
+			{Field: "spiffeID", Pattern: "spiffe://example.org/ns/*/worker", Permissions: []string{"$1:worker"}},
+			{Field: "dnsName", Pattern: "admin.example.org", Permissions: []string{primitives.SystemLocalNamespace + ":admin"}},
+			{Field: "uri", Pattern: "https://*", Permissions: []string{"default:read"}},
+		},
+	})
+
+	spiffeID, err := url.Parse("spiffe://example.org/ns/billing/worker")
+	s.NoError(err)
+	claims, err := claimMapper.GetClaims(certificateAuthInfo(&x509.Certificate{
+		Subject:  pkix.Name{CommonName: "billing-worker"},
+		DNSNames: []string{"billing.example.org"},
+		URIs:     []*url.URL{spiffeID},
+	}))
+	s.NoError(err)
+	s.Equal("spiffe://example.org/ns/billing/worker", claims.Subject)
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{"billing": RoleReader | RoleWorker}, claims.Namespaces)
+}
+
+func (s *certificateClaimMapperSuite) TestWildcardMatchesSingleSegment() {
+	dot := "."
+	claimMapper := s.newClaimMapper(config.CertificateClaimMapper{
+		Rules: []config.CertificateClaimRule{
+			{Field: "spiffeID", Pattern: "spiffe://example.org/ns/*/worker", Permissions: []string{"$1:worker"}},
+			{Field: "commonName", Pattern: "*.workers.example.com", Permissions: []string{"$1:write"}, WildcardSeparators: &dot},
+		},
+	})
+
+	spiffeID, err := url.Parse("spiffe://example.org/ns/billing/other/worker")
+	s.NoError(err)
+	claims, err := claimMapper.GetClaims(certificateAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "payments.evil.workers.example.com"},
+		URIs:    []*url.URL{spiffeID},
+	}))
+	s.NoError(err)
+	s.Nil(claims.Namespaces)
+}
+
+func (s *certificateClaimMapperSuite) TestWildcardMatchesDots() {
+	claimMapper := s.newClaimMapper(config.CertificateClaimMapper{
+		Rules: []config.CertificateClaimRule{
+			{Field: "spiffeID", Pattern: "spiffe://example.org/ns/*/worker", Permissions: []string{"$1:worker"}},
+		},
+	})
+
+	spiffeID, err := url.Parse("spiffe://example.org/ns/payments.prod/worker")
+	s.NoError(err)
+	claims, err := claimMapper.GetClaims(certificateAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "worker"},
+		URIs:    []*url.URL{spiffeID},
+	}))
+	s.NoError(err)
+	s.Equal(map[string]Role{"payments.prod": RoleWorker}, claims.Namespaces)
+}
+
+func (s *certificateClaimMapperSuite) TestTemplatedSystemNamespace() {
+	claimMapper := s.newClaimMapper(config.CertificateClaimMapper{
+		Rules: []config.CertificateClaimRule{
+			{Field: "spiffeID", Pattern: "spiffe://example.org/ns/*/admin", Permissions: []string{"$1:admin"}},
+			{Field: "organizationalUnit", Pattern: "ops", Permissions: []string{primitives.SystemLocalNamespace + ":read"}},
+		},
+	})
+
+	spiffeID, err := url.Parse("spiffe://example.org/ns/" + primitives.SystemLocalNamespace + "/admin")
+	s.NoError(err)
+	claims, err := claimMapper.GetClaims(certificateAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "admin", OrganizationalUnit: []string{"ops"}},
+		URIs:    []*url.URL{spiffeID},
+	}))
+	s.NoError(err)
+	s.Equal(RoleReader, claims.System)
+	s.Nil(claims.Namespaces)
+}
+
+func (s *certificateClaimMapperSuite) TestSubjectField() {
+	claimMapper := s.newClaimMapper(config.CertificateClaimMapper{SubjectField: "dnsName"})
+
+	claims, err := claimMapper.GetClaims(certificateAuthInfo(&x509.Certificate{
+		Subject:  pkix.Name{CommonName: "frontend"},
+		DNSNames: []string{"frontend.example.org"},
+	}))
+	s.NoError(err)
+	s.Equal("frontend.example.org", claims.Subject)
+	s.Nil(claims.Namespaces)
+}
+
+func (s *certificateClaimMapperSuite) TestNoCertificate() {
+	claimMapper := s.newClaimMapper(config.CertificateClaimMapper{
+		Rules: []config.CertificateClaimRule{
+			{Field: "commonName", Pattern: "*", Permissions: []string{"default:read"}},
+		},
+	})
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer token"})
+	s.NoError(err)
+	s.Equal(Claims{}, *claims)
+}
+
+func (s *certificateClaimMapperSuite) TestInvalidConfig() {
+	for _, cfg := range []config.CertificateClaimMapper{
+		{SubjectField: "serialNumber"},
+		{Rules: []config.CertificateClaimRule{{Field: "serialNumber", Pattern: "*"}}},
+		{Rules: []config.CertificateClaimRule{{Field: "commonName"}}},
+		{Rules: []config.CertificateClaimRule{{Field: "commonName", Pattern: "*", Permissions: []string{"default"}}}},
+		{Rules: []config.CertificateClaimRule{{Field: "commonName", Pattern: "*", Permissions: []string{"default:owner"}}}},
+	} {
+		_, err := NewCertificateClaimMapper(&cfg, log.NewNoopLogger())
+		s.Error(err)
+	}
+}
@@ -90,6 +90,8 @@ func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (
 		return NewNoopClaimMapper(), nil
 	case "default":
 		return NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger), nil
+	case "certificate":
+		return NewCertificateClaimMapper(&config.CertificateClaimMapper, logger)
 	}
 	return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
 }
@@ -35,6 +35,7 @@ import (
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 var (
@@ -193,7 +194,7 @@ func (s *defaultAuthorizerSuite) TestGetAuthorizerFromConfigUnknown() {
 func (s *defaultAuthorizerSuite) testGetAuthorizerFromConfig(name string, valid bool, authorizerType reflect.Type) {
 
 	cfg := config.Authorization{Authorizer: name}
-	auth, err := GetAuthorizerFromConfig(&cfg)
+	auth, err := GetAuthorizerFromConfig(&cfg, log.NewNoopLogger())
 	if valid {
 		s.NoError(err)
 		s.NotNil(auth)
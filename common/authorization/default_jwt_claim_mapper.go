@@ -53,6 +53,9 @@ type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
 	logger               log.Logger
 	permissionsClaimName string
+	issuers              map[string]*jwtIssuer
+	// whether tokens from issuers not in issuers are validated with keyProvider
+	allowOtherIssuers bool
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
@@ -60,7 +63,21 @@ func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorizati
 	if claimName == "" {
 		claimName = defaultPermissionsClaimName
 	}
-	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
+	issuers := make(map[string]*jwtIssuer, len(cfg.Issuers))
+	for _, issuerCfg := range cfg.Issuers {
+		if issuerCfg.Issuer == "" {
+			logger.Warn("ignoring JWT issuer config with no issuer")
+			continue
+		}
+		issuers[issuerCfg.Issuer] = newJWTIssuer(issuerCfg, logger)
+	}
+	return &defaultJWTClaimMapper{
+		keyProvider:          provider,
+		logger:               logger,
+		permissionsClaimName: claimName,
+		issuers:              issuers,
+		allowOtherIssuers:    len(issuers) == 0 || cfg.JWTKeyProvider.HasSourceURIsConfigured(),
+	}
 }
 
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
@@ -80,7 +97,15 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if !strings.EqualFold(parts[0], authorizationBearer) {
 		return nil, serviceerror.NewPermissionDenied("unexpected name in authorization token", "")
 	}
-	jwtClaims, err := parseJWTWithAudience(parts[1], a.keyProvider, authInfo.Audience)
+	issuer, err := a.issuerForToken(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	keyProvider := a.keyProvider
+	if issuer != nil {
+		keyProvider = issuer.keyProvider
+	}
+	jwtClaims, err := parseJWTWithAudience(parts[1], keyProvider, authInfo.Audience)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +114,16 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 		return nil, serviceerror.NewPermissionDenied("unexpected value type of \"sub\" claim", "")
 	}
 	claims.Subject = subject
+	if issuer != nil {
+		if !issuer.verifyAudience(jwtClaims) {
+			return nil, serviceerror.NewPermissionDenied("audience mismatch", "")
+		}
+		err := a.extractPermissions(issuer.permissions(jwtClaims), &claims)
+		if err != nil {
+			return nil, err
+		}
+		return &claims, nil
+	}
 	permissions, ok := jwtClaims[a.permissionsClaimName].([]interface{})
 	if ok {
 		err := a.extractPermissions(permissions, &claims)
@@ -99,6 +134,25 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	return &claims, nil
 }
 
+// issuerForToken returns the configured issuer of a token, or nil if the token
+// should be validated with the default key provider.
+func (a *defaultJWTClaimMapper) issuerForToken(tokenString string) (*jwtIssuer, error) {
+	if len(a.issuers) == 0 {
+		return nil, nil
+	}
+	name, err := tokenIssuer(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if issuer, ok := a.issuers[name]; ok {
+		return issuer, nil
+	}
+	if !a.allowOtherIssuers {
+		return nil, serviceerror.NewPermissionDenied(fmt.Sprintf("untrusted token issuer: %q", name), "")
+	}
+	return nil, nil
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
@@ -25,6 +25,7 @@
 package authorization
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/rsa"
 	"encoding/json"
@@ -38,6 +39,7 @@ import (
 	"go.uber.org/multierr"
 	"gopkg.in/go-jose/go-jose.v2"
 
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -52,8 +54,24 @@ type defaultTokenKeyProvider struct {
 	ticker   *time.Ticker
 	logger   log.Logger
 	stop     chan bool
+	// If set and no key source URIs are configured, keys are loaded from the
+	// jwks_uri advertised by this issuer's OpenID Connect discovery document.
+	discoveryIssuer string
+	// cancels the initial retrieval of keys from a discovered jwks_uri
+	cancelDiscovery context.CancelFunc
 }
 
+const (
+	keyRetrievalTimeout = 10 * time.Second
+
+	discoveryInitialRetryInterval = time.Second
+	discoveryMaxRetryInterval     = time.Minute
+)
+
+// keyRetrievalClient is used for all requests to token key sources and OpenID Connect
+// discovery endpoints, so that an unresponsive identity provider can't hang a caller.
+var keyRetrievalClient = &http.Client{Timeout: keyRetrievalTimeout}
+
 var _ TokenKeyProvider = (*defaultTokenKeyProvider)(nil)
 
 func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *defaultTokenKeyProvider {
@@ -62,11 +80,29 @@ func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *d
 	return &provider
 }
 
+func newIssuerTokenKeyProvider(issuer config.JWTIssuer, logger log.Logger) *defaultTokenKeyProvider {
+	provider := defaultTokenKeyProvider{
+		config: config.JWTKeyProvider{
+			KeySourceURIs:   issuer.KeySourceURIs,
+			RefreshInterval: issuer.RefreshInterval,
+		},
+		discoveryIssuer: issuer.Issuer,
+		logger:          log.With(logger, tag.NewStringTag("issuer", issuer.Issuer)),
+	}
+	provider.initialize()
+	return &provider
+}
+
 func (a *defaultTokenKeyProvider) initialize() {
 	a.rsaKeys = make(map[string]*rsa.PublicKey)
 	a.ecKeys = make(map[string]*ecdsa.PublicKey)
-	if a.config.HasSourceURIsConfigured() {
-		err := a.updateKeys()
+	if a.discoveryIssuer != "" && !a.config.HasSourceURIsConfigured() {
+		// the identity provider may not be reachable yet, so don't block startup on it
+		var ctx context.Context
+		ctx, a.cancelDiscovery = context.WithCancel(context.Background())
+		go a.discoverKeys(ctx)
+	} else if a.hasKeySources() {
+		err := a.updateKeys(context.Background())
 		if err != nil {
 			a.logger.Error("error during initial retrieval of token keys: ", tag.Error(err))
 		}
@@ -78,7 +114,25 @@ func (a *defaultTokenKeyProvider) initialize() {
 	}
 }
 
+// discoverKeys retrieves the keys from the discovered jwks_uri, retrying until it succeeds or
+// the provider is closed. Tokens of the issuer are rejected until then.
+func (a *defaultTokenKeyProvider) discoverKeys(ctx context.Context) {
+	policy := backoff.NewExponentialRetryPolicy(discoveryInitialRetryInterval).
+		WithMaximumInterval(discoveryMaxRetryInterval).
+		WithExpirationInterval(backoff.NoInterval)
+	_ = backoff.ThrottleRetryContext(ctx, func(ctx context.Context) error {
+		err := a.updateKeys(ctx)
+		if err != nil {
+			a.logger.Warn("error during initial retrieval of token keys, will retry: ", tag.Error(err))
+		}
+		return err
+	}, policy, nil)
+}
+
 func (a *defaultTokenKeyProvider) Close() {
+	if a.cancelDiscovery != nil {
+		a.cancelDiscovery()
+	}
 	a.ticker.Stop()
 	a.stop <- true
 	close(a.stop)
@@ -123,8 +177,8 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 			return
 		case <-a.ticker.C:
 		}
-		if a.config.HasSourceURIsConfigured() {
-			err := a.updateKeys()
+		if a.hasKeySources() {
+			err := a.updateKeys(context.Background())
 			if err != nil {
 				a.logger.Error("error while refreshing token keys: ", tag.Error(err))
 			}
@@ -132,19 +186,38 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 	}
 }
 
-func (a *defaultTokenKeyProvider) updateKeys() error {
-	if !a.config.HasSourceURIsConfigured() {
-		return fmt.Errorf("no URIs configured for retrieving token keys")
+func (a *defaultTokenKeyProvider) hasKeySources() bool {
+	return a.config.HasSourceURIsConfigured() || a.discoveryIssuer != ""
+}
+
+func (a *defaultTokenKeyProvider) keySourceURIs(ctx context.Context) ([]string, error) {
+	if a.config.HasSourceURIsConfigured() {
+		return a.config.KeySourceURIs, nil
+	}
+	if a.discoveryIssuer == "" {
+		return nil, fmt.Errorf("no URIs configured for retrieving token keys")
+	}
+	uri, err := discoverJWKSURI(ctx, a.discoveryIssuer)
+	if err != nil {
+		return nil, err
+	}
+	return []string{uri}, nil
+}
+
+func (a *defaultTokenKeyProvider) updateKeys(ctx context.Context) error {
+	uris, err := a.keySourceURIs(ctx)
+	if err != nil {
+		return err
 	}
 
 	rsaKeys := make(map[string]*rsa.PublicKey)
 	ecKeys := make(map[string]*ecdsa.PublicKey)
 
-	for _, uri := range a.config.KeySourceURIs {
+	for _, uri := range uris {
 		if strings.TrimSpace(uri) == "" {
 			continue
 		}
-		err := a.updateKeysFromURI(uri, rsaKeys, ecKeys)
+		err := a.updateKeysFromURI(ctx, uri, rsaKeys, ecKeys)
 		if err != nil {
 			return err
 		}
@@ -158,12 +231,17 @@ func (a *defaultTokenKeyProvider) updateKeys() error {
 }
 
 func (a *defaultTokenKeyProvider) updateKeysFromURI(
+	ctx context.Context,
 	uri string,
 	rsaKeys map[string]*rsa.PublicKey,
 	ecKeys map[string]*ecdsa.PublicKey,
 ) (err error) {
 
-	resp, err := http.Get(uri)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := keyRetrievalClient.Do(req)
 	if err != nil {
 		return err
 	}
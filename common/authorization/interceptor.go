@@ -31,7 +31,9 @@ import (
 	"crypto/x509/pkix"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
@@ -58,6 +60,12 @@ type (
 		// Exists returns nil if the namespace exists, otherwise an error.
 		Exists(name namespace.Name) error
 	}
+
+	// WorkflowTypeResolver looks up the workflow type of an existing workflow execution, for
+	// CallTarget.ResolveWorkflowType. It returns an empty string if the execution doesn't exist.
+	WorkflowTypeResolver interface {
+		ResolveWorkflowType(ctx context.Context, namespace namespace.Name, execution *commonpb.WorkflowExecution) (string, error)
+	}
 )
 
 const (
@@ -109,6 +117,8 @@ type Interceptor struct {
 	audienceGetter      JWTAudienceMapper
 	authHeaderName      string
 	authExtraHeaderName string
+	workflowTypes       WorkflowTypeResolver
+	auditor             *Auditor
 }
 
 // NewInterceptor creates an authorization interceptor.
@@ -121,6 +131,8 @@ func NewInterceptor(
 	audienceGetter JWTAudienceMapper,
 	authHeaderName string,
 	authExtraHeaderName string,
+	workflowTypes WorkflowTypeResolver,
+	auditor *Auditor,
 ) *Interceptor {
 	return &Interceptor{
 		claimMapper:         claimMapper,
@@ -131,6 +143,8 @@ func NewInterceptor(
 		authHeaderName:      cmp.Or(authHeaderName, defaultAuthHeaderName),
 		authExtraHeaderName: cmp.Or(authExtraHeaderName, defaultAuthExtraHeaderName),
 		audienceGetter:      audienceGetter,
+		workflowTypes:       workflowTypes,
+		auditor:             auditor,
 	}
 }
 
@@ -155,6 +169,9 @@ func (a *Interceptor) Intercept(
 		claims, err = a.GetClaims(authInfo)
 		if err != nil {
 			a.logger.Error("Authorization error", tag.Error(err))
+			if a.authorizer != nil {
+				a.audit(ctx, nil, a.newCallTarget(req, info), DecisionDeny, "claim mapper error: "+err.Error())
+			}
 			// return a generic error to the caller without disclosing details
 			return nil, errUnauthorized
 		}
@@ -162,16 +179,8 @@ func (a *Interceptor) Intercept(
 	}
 
 	if a.authorizer != nil {
-		var namespace string
-		requestWithNamespace, ok := req.(hasNamespace)
-		if ok {
-			namespace = requestWithNamespace.GetNamespace()
-		}
-		ct := &CallTarget{
-			Namespace: namespace,
-			APIName:   info.FullMethod,
-			Request:   req,
-		}
+		ct := a.newCallTarget(req, info)
+		a.fillWorkflowTarget(ct, req)
 		if err := a.Authorize(ctx, claims, ct); err != nil {
 			return nil, err
 		}
@@ -179,6 +188,52 @@ func (a *Interceptor) Intercept(
 	return handler(ctx, req)
 }
 
+func (a *Interceptor) newCallTarget(req interface{}, info *grpc.UnaryServerInfo) *CallTarget {
+	var namespace string
+	requestWithNamespace, ok := req.(hasNamespace)
+	if ok {
+		namespace = requestWithNamespace.GetNamespace()
+	}
+	return &CallTarget{
+		Namespace: namespace,
+		APIName:   info.FullMethod,
+		Request:   req,
+	}
+}
+
+// fillWorkflowTarget sets the workflow type and task queue of the call target from the request.
+func (a *Interceptor) fillWorkflowTarget(ct *CallTarget, req interface{}) {
+	if r, ok := req.(hasWorkflowType); ok {
+		ct.WorkflowType = r.GetWorkflowType().GetName()
+	}
+	if r, ok := req.(hasTaskQueue); ok {
+		ct.TaskQueue = r.GetTaskQueue().GetName()
+	}
+	if a.workflowTypes == nil || ct.Namespace == "" {
+		return
+	}
+	var execution *commonpb.WorkflowExecution
+	switch r := req.(type) {
+	case *workflowservice.SignalWithStartWorkflowExecutionRequest:
+		// The signal goes to the running execution with the workflow ID if there is one,
+		// and its type doesn't have to match the type in the request.
+		execution = &commonpb.WorkflowExecution{WorkflowId: r.GetWorkflowId()}
+	case hasWorkflowExecution:
+		execution = r.GetWorkflowExecution()
+	case hasExecution:
+		execution = r.GetExecution()
+	}
+	if execution.GetWorkflowId() == "" {
+		return
+	}
+	if _, ok := req.(*workflowservice.SignalWithStartWorkflowExecutionRequest); !ok && ct.WorkflowType != "" {
+		return
+	}
+	ct.workflowTypeLookup = func(ctx context.Context) (string, error) {
+		return a.workflowTypes.ResolveWorkflowType(ctx, namespace.Name(ct.Namespace), execution)
+	}
+}
+
 // GetAuthInfo extracts auth info from TLS info and headers.
 // Returns nil if either the policy's claimMapper or authorizer are nil or when there is no auth information in the
 // provided TLS info or headers.
@@ -233,7 +288,7 @@ func (a *Interceptor) EnhanceContext(ctx context.Context, authInfo *AuthInfo, cl
 }
 
 // Authorize uses the policy's authorizer to authorize a request based on provided claims and call target.
-// Logs and emits metrics when unauthorized.
+// Logs and emits metrics when unauthorized, and records the decision with the auditor if there is one.
 func (a *Interceptor) Authorize(ctx context.Context, claims *Claims, ct *CallTarget) error {
 	if a.authorizer == nil {
 		return nil
@@ -247,8 +302,11 @@ func (a *Interceptor) Authorize(ctx context.Context, claims *Claims, ct *CallTar
 	if err != nil {
 		metrics.ServiceErrAuthorizeFailedCounter.With(mh).Record(1)
 		a.logger.Error("Authorization error", tag.Error(err))
+		a.audit(ctx, claims, ct, DecisionDeny, "authorizer error: "+err.Error())
 		return errUnauthorized // return a generic error to the caller without disclosing details
 	}
+	metrics.ServiceAuthorizationDecisions.With(mh).Record(1, metrics.StringTag("decision", result.Decision.String()))
+	a.audit(ctx, claims, ct, result.Decision, result.Reason)
 	if result.Decision != DecisionAllow {
 		metrics.ServiceErrUnauthorizedCounter.With(mh).Record(1)
 		// if a reason is included in the result, include it in the error message
@@ -260,6 +318,17 @@ func (a *Interceptor) Authorize(ctx context.Context, claims *Claims, ct *CallTar
 	return nil
 }
 
+// audit records an authorization decision with the auditor, if there is one.
+func (a *Interceptor) audit(ctx context.Context, claims *Claims, ct *CallTarget, decision Decision, reason string) {
+	if a.auditor == nil {
+		return
+	}
+	if err := a.auditor.Audit(ctx, claims, ct, decision, reason); err != nil {
+		metrics.ServiceAuthorizationAuditFailures.With(a.getMetricsHandler(ct.Namespace)).Record(1)
+		a.logger.Error("Unable to record authorization audit record", tag.Error(err))
+	}
+}
+
 // getMetricsHandler returns a metrics handler with a namespace tag
 func (a *Interceptor) getMetricsHandler(nsName string) metrics.Handler {
 	nsTag := metrics.NamespaceUnknownTag()
@@ -32,11 +32,14 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -71,6 +74,8 @@ type (
 	}
 
 	mockNamespaceChecker namespace.Name
+
+	mockWorkflowTypeResolver func(context.Context, namespace.Name, *commonpb.WorkflowExecution) (string, error)
 )
 
 func TestAuthorizerInterceptorSuite(t *testing.T) {
@@ -90,6 +95,7 @@ func (s *authorizerInterceptorSuite) SetupTest() {
 		metrics.NamespaceTag(testNamespace),
 	).Return(s.mockMetricsHandler).AnyTimes()
 	s.mockMetricsHandler.EXPECT().Timer(metrics.ServiceAuthorizationLatency.Name()).Return(metrics.NoopTimerMetricFunc).AnyTimes()
+	s.mockMetricsHandler.EXPECT().Counter(metrics.ServiceAuthorizationDecisions.Name()).Return(metrics.NoopCounterMetricFunc).AnyTimes()
 
 	s.mockClaimMapper = NewMockClaimMapper(s.controller)
 	s.interceptor = NewInterceptor(
@@ -101,6 +107,8 @@ func (s *authorizerInterceptorSuite) SetupTest() {
 		nil,
 		"",
 		"",
+		nil,
+		nil,
 	)
 	s.handler = func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
 }
@@ -127,6 +135,92 @@ func (s *authorizerInterceptorSuite) TestIsAuthorizedWithNamespace() {
 	s.NoError(err)
 }
 
+func (s *authorizerInterceptorSuite) TestWorkflowTarget() {
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:    testNamespace,
+		WorkflowType: &commonpb.WorkflowType{Name: "billing-invoice"},
+		TaskQueue:    &taskqueuepb.TaskQueue{Name: "billing"},
+	}
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, caller *Claims, target *CallTarget) (Result, error) {
+			s.Equal("billing-invoice", target.WorkflowType)
+			s.Equal("billing", target.TaskQueue)
+			return Result{Decision: DecisionAllow}, nil
+		})
+
+	_, err := s.interceptor.Intercept(ctx, request, startWorkflowExecutionInfo, s.handler)
+	s.NoError(err)
+}
+
+func (s *authorizerInterceptorSuite) TestWorkflowTypeResolver() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wid"}
+	resolver := mockWorkflowTypeResolver(func(ctx context.Context, ns namespace.Name, e *commonpb.WorkflowExecution) (string, error) {
+		s.Equal(testNamespace, ns.String())
+		s.Equal(execution, e)
+		return "billing-invoice", nil
+	})
+	interceptor := NewInterceptor(
+		s.mockClaimMapper,
+		s.mockAuthorizer,
+		s.mockMetricsHandler,
+		log.NewNoopLogger(),
+		mockNamespaceChecker(testNamespace),
+		nil,
+		"",
+		"",
+		resolver,
+		nil,
+	)
+	request := &workflowservice.SignalWorkflowExecutionRequest{Namespace: testNamespace, WorkflowExecution: execution}
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, caller *Claims, target *CallTarget) (Result, error) {
+			s.Empty(target.WorkflowType)
+			workflowType, err := target.ResolveWorkflowType(ctx)
+			s.NoError(err)
+			s.Equal("billing-invoice", workflowType)
+			return Result{Decision: DecisionAllow}, nil
+		})
+
+	_, err := interceptor.Intercept(ctx, request, startWorkflowExecutionInfo, s.handler)
+	s.NoError(err)
+}
+
+func (s *authorizerInterceptorSuite) TestWorkflowTypeResolver_SignalWithStart() {
+	resolver := mockWorkflowTypeResolver(func(ctx context.Context, ns namespace.Name, e *commonpb.WorkflowExecution) (string, error) {
+		s.Equal(testNamespace, ns.String())
+		s.Equal("wid", e.GetWorkflowId())
+		return "shipping", nil
+	})
+	interceptor := NewInterceptor(
+		s.mockClaimMapper,
+		s.mockAuthorizer,
+		s.mockMetricsHandler,
+		log.NewNoopLogger(),
+		mockNamespaceChecker(testNamespace),
+		nil,
+		"",
+		"",
+		resolver,
+		nil,
+	)
+	request := &workflowservice.SignalWithStartWorkflowExecutionRequest{
+		Namespace:    testNamespace,
+		WorkflowId:   "wid",
+		WorkflowType: &commonpb.WorkflowType{Name: "billing-invoice"},
+	}
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, caller *Claims, target *CallTarget) (Result, error) {
+			s.Equal("billing-invoice", target.WorkflowType)
+			workflowTypes, err := target.ResolveWorkflowTypes(ctx)
+			s.NoError(err)
+			s.Equal([]string{"billing-invoice", "shipping"}, workflowTypes)
+			return Result{Decision: DecisionAllow}, nil
+		})
+
+	_, err := interceptor.Intercept(ctx, request, startWorkflowExecutionInfo, s.handler)
+	s.NoError(err)
+}
+
 func (s *authorizerInterceptorSuite) TestIsUnauthorized() {
 	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).
 		Return(Result{Decision: DecisionDeny}, nil)
@@ -147,6 +241,7 @@ func (s *authorizerInterceptorSuite) TestIsUnknown() {
 		metrics.NamespaceUnknownTag(), // note: should use unknown tag since unknown-namespace is not registered
 	).Return(handler).AnyTimes()
 	handler.EXPECT().Counter(metrics.ServiceErrUnauthorizedCounter.Name()).Return(metrics.NoopCounterMetricFunc)
+	handler.EXPECT().Counter(metrics.ServiceAuthorizationDecisions.Name()).Return(metrics.NoopCounterMetricFunc)
 	handler.EXPECT().Timer(metrics.ServiceAuthorizationLatency.Name()).Return(metrics.NoopTimerMetricFunc)
 
 	res, err := s.interceptor.Intercept(ctx, request, describeNamespaceInfo, s.handler)
@@ -183,6 +278,8 @@ func (s *authorizerInterceptorSuite) TestNoopClaimMapperWithoutTLS() {
 		nil,
 		"",
 		"",
+		nil,
+		nil,
 	)
 	_, err := interceptor.Intercept(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
 	s.NoError(err)
@@ -198,6 +295,8 @@ func (s *authorizerInterceptorSuite) TestAlternateHeaders() {
 		nil,
 		"custom-header",
 		"custom-extra-header",
+		nil,
+		nil,
 	)
 
 	cases := []struct {
@@ -240,6 +339,48 @@ func (s *authorizerInterceptorSuite) TestAlternateHeaders() {
 	}
 }
 
+func (s *authorizerInterceptorSuite) TestAudit() {
+	sink := &testAuditSink{}
+	auditor, err := NewAuditor(sink, &config.AuthorizationAudit{})
+	s.NoError(err)
+	interceptor := NewInterceptor(
+		s.mockClaimMapper,
+		s.mockAuthorizer,
+		s.mockMetricsHandler,
+		log.NewNoopLogger(),
+		mockNamespaceChecker(testNamespace),
+		nil,
+		"",
+		"",
+		nil,
+		auditor,
+	)
+
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).Return(Result{Decision: DecisionAllow}, nil)
+	_, err = interceptor.Intercept(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.NoError(err)
+
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).Return(Result{Decision: DecisionDeny, Reason: "no role"}, nil)
+	s.mockMetricsHandler.EXPECT().Counter(metrics.ServiceErrUnauthorizedCounter.Name()).Return(metrics.NoopCounterMetricFunc)
+	_, err = interceptor.Intercept(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.Error(err)
+
+	s.Len(sink.records, 2)
+	s.Equal(describeNamespaceTarget.APIName, sink.records[0].API)
+	s.Equal(testNamespace, sink.records[0].Namespace)
+	s.Equal("allow", sink.records[0].Decision)
+	s.Equal("deny", sink.records[1].Decision)
+	s.Equal("no role", sink.records[1].Reason)
+}
+
+func (f mockWorkflowTypeResolver) ResolveWorkflowType(
+	ctx context.Context,
+	ns namespace.Name,
+	execution *commonpb.WorkflowExecution,
+) (string, error) {
+	return f(ctx, ns, execution)
+}
+
 func (n mockNamespaceChecker) Exists(name namespace.Name) error {
 	if name == namespace.Name(n) {
 		return nil
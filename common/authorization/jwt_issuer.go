@@ -0,0 +1,173 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/multierr"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const (
+	openIDConfigurationPath = "/.well-known/openid-configuration"
+	headerIssuer            = "iss"
+)
+
+type (
+	// jwtIssuer holds the keys and claim mapping for an identity provider trusted by defaultJWTClaimMapper
+	jwtIssuer struct {
+		issuer               string
+		keyProvider          TokenKeyProvider
+		audiences            []string
+		permissionsClaimName string
+		groupsClaimName      string
+		groupPermissions     map[string][]string
+	}
+
+	openIDConfiguration struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+)
+
+func newJWTIssuer(cfg config.JWTIssuer, logger log.Logger) *jwtIssuer {
+	claimName := cfg.PermissionsClaimName
+	if claimName == "" {
+		claimName = defaultPermissionsClaimName
+	}
+	return &jwtIssuer{
+		issuer:               cfg.Issuer,
+		keyProvider:          newIssuerTokenKeyProvider(cfg, logger),
+		audiences:            cfg.Audiences,
+		permissionsClaimName: claimName,
+		groupsClaimName:      cfg.GroupsClaimName,
+		groupPermissions:     cfg.GroupPermissions,
+	}
+}
+
+// verifyAudience returns true if no audiences are configured for the issuer
+// or the "aud" claim contains one of them.
+func (i *jwtIssuer) verifyAudience(claims jwt.MapClaims) bool {
+	if len(i.audiences) == 0 {
+		return true
+	}
+	for _, audience := range i.audiences {
+		if claims.VerifyAudience(audience, true) {
+			return true
+		}
+	}
+	return false
+}
+
+// permissions returns the permissions carried by the token directly
+// and the permissions mapped from its groups.
+func (i *jwtIssuer) permissions(claims jwt.MapClaims) []interface{} {
+	permissions := append([]interface{}(nil), claimValues(claims, i.permissionsClaimName)...)
+	if i.groupsClaimName == "" {
+		return permissions
+	}
+	for _, group := range claimValues(claims, i.groupsClaimName) {
+		name, ok := group.(string)
+		if !ok {
+			continue
+		}
+		for _, permission := range i.groupPermissions[name] {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
+// claimValues looks up a claim by name, falling back to a dotted path into nested claims,
+// and returns its values. A single string value is returned as a one element list.
+func claimValues(claims jwt.MapClaims, name string) []interface{} {
+	value, ok := claims[name]
+	if !ok {
+		var current interface{} = map[string]interface{}(claims)
+		for _, part := range strings.Split(name, ".") {
+			nested, ok := current.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			current = nested[part]
+		}
+		value = current
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case string:
+		return []interface{}{v}
+	}
+	return nil
+}
+
+// tokenIssuer returns the "iss" claim of a token without verifying its signature.
+func tokenIssuer(tokenString string) (string, error) {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, claims); err != nil {
+		return "", err
+	}
+	issuer, _ := claims[headerIssuer].(string)
+	return issuer, nil
+}
+
+// discoverJWKSURI retrieves the OpenID Connect discovery document of an issuer and returns its JWKS URI.
+func discoverJWKSURI(ctx context.Context, issuer string) (_ string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(issuer, "/")+openIDConfigurationPath, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := keyRetrievalClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = multierr.Combine(err, resp.Body.Close())
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d from OpenID configuration endpoint of %s", resp.StatusCode, issuer)
+	}
+
+	var doc openIDConfiguration
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", err
+	}
+	if doc.Issuer != issuer {
+		return "", fmt.Errorf("OpenID configuration issuer %q does not match %q", doc.Issuer, issuer)
+	}
+	if doc.JWKSURI == "" {
+		return "", fmt.Errorf("OpenID configuration of %s has no jwks_uri", issuer)
+	}
+	return doc.JWKSURI, nil
+}
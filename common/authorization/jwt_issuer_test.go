@@ -0,0 +1,251 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/go-jose/go-jose.v2"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	jwtIssuerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		tokenGenerator *tokenGenerator
+		server         *httptest.Server
+		discoveryCalls atomic.Int32
+		// number of discovery calls that fail before the discovery endpoint responds
+		discoveryFailures atomic.Int32
+	}
+)
+
+func TestJWTIssuerSuite(t *testing.T) {
+	s := new(jwtIssuerSuite)
+	suite.Run(t, s)
+}
+
+func (s *jwtIssuerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.tokenGenerator = newTokenGenerator()
+	s.discoveryCalls.Store(0)
+	s.discoveryFailures.Store(0)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(openIDConfigurationPath, func(w http.ResponseWriter, r *http.Request) {
+		if s.discoveryCalls.Add(1) <= s.discoveryFailures.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(openIDConfiguration{
+			Issuer:  s.server.URL,
+			JWKSURI: s.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{{
+				Key:       s.tokenGenerator.rsaPublicKey,
+				KeyID:     "test-key",
+				Algorithm: jwt.SigningMethodRS256.Name,
+				Use:       "sig",
+			}},
+		})
+	})
+	s.server = httptest.NewServer(mux)
+}
+
+func (s *jwtIssuerSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *jwtIssuerSuite) newClaimMapper(issuer config.JWTIssuer) ClaimMapper {
+	cfg := &config.Authorization{Issuers: []config.JWTIssuer{issuer}}
+	return NewDefaultJWTClaimMapper(s.tokenGenerator, cfg, log.NewNoopLogger())
+}
+
+func (s *jwtIssuerSuite) token(issuer string, claims jwt.MapClaims) string {
+	claims["iss"] = issuer
+	claims["sub"] = testSubject
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	tokenString, err := token.SignedString(s.tokenGenerator.rsaPrivateKey)
+	s.NoError(err)
+	return AddBearer(tokenString)
+}
+
+// waitForKeys waits until the keys of an issuer that uses discovery have been retrieved.
+func (s *jwtIssuerSuite) waitForKeys(claimMapper ClaimMapper, issuer string) {
+	s.Eventually(func() bool {
+		_, err := claimMapper.GetClaims(&AuthInfo{AuthToken: s.token(issuer, jwt.MapClaims{})})
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func (s *jwtIssuerSuite) TestDiscovery() {
+	claimMapper := s.newClaimMapper(config.JWTIssuer{Issuer: s.server.URL})
+	s.waitForKeys(claimMapper, s.server.URL)
+	s.Equal(int32(1), s.discoveryCalls.Load())
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{
+		AuthToken: s.token(s.server.URL, jwt.MapClaims{"permissions": []string{"default:write"}}),
+	})
+	s.NoError(err)
+	s.Equal(testSubject, claims.Subject)
+	s.Equal(map[string]Role{defaultNamespace: RoleWriter}, claims.Namespaces)
+}
+
+func (s *jwtIssuerSuite) TestDiscoveryRetry() {
+	s.discoveryFailures.Store(1)
+	claimMapper := s.newClaimMapper(config.JWTIssuer{Issuer: s.server.URL})
+
+	// tokens are rejected until the keys have been retrieved
+	s.waitForKeys(claimMapper, s.server.URL)
+	s.Equal(int32(2), s.discoveryCalls.Load())
+}
+
+func (s *jwtIssuerSuite) TestDiscoveryIssuerMismatch() {
+	_, err := discoverJWKSURI(context.Background(), s.server.URL+"/other")
+	s.Error(err)
+}
+
+func (s *jwtIssuerSuite) TestDiscoveryTimeout() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	blocked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer blocked.Close()
+
+	_, err := discoverJWKSURI(ctx, blocked.URL)
+	s.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (s *jwtIssuerSuite) TestStaticKeySourceURIs() {
+	claimMapper := s.newClaimMapper(config.JWTIssuer{
+		Issuer:        "https://issuer.example.com",
+		KeySourceURIs: []string{s.server.URL + "/keys"},
+	})
+	s.Equal(int32(0), s.discoveryCalls.Load())
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{
+		AuthToken: s.token("https://issuer.example.com", jwt.MapClaims{}),
+	})
+	s.NoError(err)
+	s.Equal(testSubject, claims.Subject)
+}
+
+func (s *jwtIssuerSuite) TestClaimMapping() {
+	claimMapper := s.newClaimMapper(config.JWTIssuer{
+		Issuer:               s.server.URL,
+		PermissionsClaimName: "realm_access.roles",
+		GroupsClaimName:      "groups",
+		GroupPermissions: map[string][]string{
+			"operators": {primitives.SystemLocalNamespace + ":admin"},
+			"payments":  {"payments:write", "payments:worker"},
+		},
+	})
+	s.waitForKeys(claimMapper, s.server.URL)
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{
+		AuthToken: s.token(s.server.URL, jwt.MapClaims{
+			"realm_access": map[string]interface{}{"roles": []string{"default:read"}},
+			"groups":       []string{"payments", "operators", "unknown"},
+		}),
+	})
+	s.NoError(err)
+	s.Equal(RoleAdmin, claims.System)
+	s.Equal(map[string]Role{
+		defaultNamespace: RoleReader,
+		"payments":       RoleWriter | RoleWorker,
+	}, claims.Namespaces)
+}
+
+func (s *jwtIssuerSuite) TestAudience() {
+	claimMapper := s.newClaimMapper(config.JWTIssuer{
+		Issuer:    s.server.URL,
+		Audiences: []string{"temporal", "temporal-ui"},
+	})
+	s.Eventually(func() bool {
+		_, err := claimMapper.GetClaims(&AuthInfo{
+			AuthToken: s.token(s.server.URL, jwt.MapClaims{"aud": "temporal"}),
+		})
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err := claimMapper.GetClaims(&AuthInfo{
+		AuthToken: s.token(s.server.URL, jwt.MapClaims{"aud": []string{"temporal-ui"}}),
+	})
+	s.NoError(err)
+
+	_, err = claimMapper.GetClaims(&AuthInfo{
+		AuthToken: s.token(s.server.URL, jwt.MapClaims{"aud": "other"}),
+	})
+	s.Error(err)
+
+	_, err = claimMapper.GetClaims(&AuthInfo{
+		AuthToken: s.token(s.server.URL, jwt.MapClaims{}),
+	})
+	s.Error(err)
+}
+
+func (s *jwtIssuerSuite) TestUntrustedIssuer() {
+	claimMapper := s.newClaimMapper(config.JWTIssuer{Issuer: s.server.URL})
+	s.waitForKeys(claimMapper, s.server.URL)
+
+	_, err := claimMapper.GetClaims(&AuthInfo{
+		AuthToken: s.token("https://other.example.com", jwt.MapClaims{}),
+	})
+	s.Error(err)
+}
+
+func (s *jwtIssuerSuite) TestOtherIssuersWithKeyProvider() {
+	cfg := &config.Authorization{
+		Issuers:        []config.JWTIssuer{{Issuer: s.server.URL}},
+		JWTKeyProvider: config.JWTKeyProvider{KeySourceURIs: []string{s.server.URL + "/keys"}},
+	}
+	claimMapper := NewDefaultJWTClaimMapper(s.tokenGenerator, cfg, log.NewNoopLogger())
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{
+		AuthToken: s.token("https://other.example.com", jwt.MapClaims{"permissions": []string{"default:read"}}),
+	})
+	s.NoError(err)
+	s.Equal(map[string]Role{defaultNamespace: RoleReader}, claims.Namespaces)
+}
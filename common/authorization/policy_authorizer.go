@@ -0,0 +1,428 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// Policy is a declarative authorization policy, evaluated by the policy authorizer.
+	// Rules are evaluated in order and the first rule that matches a request decides it. If no
+	// rule matches, DefaultDecision decides it.
+	//
+	// Example that lets team A signal or terminate only billing workflows, and doesn't let
+	// readers query workflows:
+	//
+	//	defaultDecision: roles
+	//	rules:
+	//	  - name: team-a-billing
+	//	    effect: allow
+	//	    apis: [SignalWorkflowExecution, TerminateWorkflowExecution]
+	//	    workflowTypes: ["billing-*"]
+	//	    claims:
+	//	      subjects: ["team-a:*"]
+	//	  - name: team-a-other
+	//	    effect: deny
+	//	    apis: [SignalWorkflowExecution, TerminateWorkflowExecution]
+	//	    claims:
+	//	      subjects: ["team-a:*"]
+	//	  - name: no-queries-for-readers
+	//	    effect: deny
+	//	    apis: [QueryWorkflow]
+	//	    claims:
+	//	      roles: [reader]
+	Policy struct {
+		// DefaultDecision is "deny" (the default), "allow", or "roles". "roles" uses the
+		// role-based rules of the default authorizer.
+		DefaultDecision string       `yaml:"defaultDecision"`
+		Rules           []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule allows or denies the requests that match all of its conditions. Each condition
+	// is a list of patterns and matches if any of them matches; an empty list matches
+	// everything. Patterns may use "*" as a wildcard for any sequence of characters.
+	PolicyRule struct {
+		// Name identifies the rule in the decision log.
+		Name string `yaml:"name"`
+		// Effect is "allow" or "deny".
+		Effect string `yaml:"effect"`
+		// APIs match the full API name, e.g.
+		// "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution", or the
+		// method name, e.g. "StartWorkflowExecution".
+		APIs       []string `yaml:"apis"`
+		Namespaces []string `yaml:"namespaces"`
+		// WorkflowTypes match the workflow type of the request. For requests on an existing
+		// workflow execution, the type is looked up from the execution. SignalWithStart targets
+		// both the type in the request and the type of the existing execution: an allow rule
+		// has to match both and a deny rule either of them.
+		WorkflowTypes []string             `yaml:"workflowTypes"`
+		TaskQueues    []string             `yaml:"taskQueues"`
+		Claims        PolicyClaimCondition `yaml:"claims"`
+	}
+
+	// PolicyClaimCondition matches the claims of the caller. Requests without claims don't
+	// match a rule that has a claim condition.
+	PolicyClaimCondition struct {
+		// Subjects match the subject of the claims.
+		Subjects []string `yaml:"subjects"`
+		// Roles match if the caller has any of the roles ("worker", "reader", "writer" or
+		// "admin"), either system-wide or in the namespace of the request.
+		Roles []string `yaml:"roles"`
+	}
+
+	policyAuthorizer struct {
+		config  config.PolicyAuthorizer
+		logger  log.Logger
+		policy  atomic.Pointer[compiledPolicy]
+		modTime time.Time
+		ticker  *time.Ticker
+		stop    chan bool
+	}
+
+	compiledPolicy struct {
+		rules           []compiledRule
+		defaultDecision string
+	}
+
+	compiledRule struct {
+		name          string
+		result        Result
+		apis          []*regexp.Regexp
+		namespaces    []*regexp.Regexp
+		workflowTypes []*regexp.Regexp
+		taskQueues    []*regexp.Regexp
+		subjects      []*regexp.Regexp
+		roles         Role
+	}
+)
+
+const (
+	policyDecisionAllow = "allow"
+	policyDecisionDeny  = "deny"
+	policyDecisionRoles = "roles"
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// NewPolicyAuthorizer creates an authorizer that evaluates the Policy in the configured policy
+// file. If RefreshInterval is set, the file is reloaded when it changes. If a changed file is
+// invalid, the error is logged and the previous policy stays in effect.
+func NewPolicyAuthorizer(cfg *config.PolicyAuthorizer, logger log.Logger) (Authorizer, error) {
+	if cfg.PolicyFile == "" {
+		return nil, errors.New("policy authorizer requires a policy file")
+	}
+	a := &policyAuthorizer{config: *cfg, logger: logger}
+	if err := a.reload(); err != nil {
+		return nil, err
+	}
+	if cfg.RefreshInterval > 0 {
+		a.stop = make(chan bool)
+		a.ticker = time.NewTicker(cfg.RefreshInterval)
+		go a.timerCallback()
+	}
+	return a, nil
+}
+
+// LoadPolicy parses and validates a policy.
+func LoadPolicy(data []byte) (*Policy, error) {
+	policy, err := decodePolicy(data)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := compilePolicy(policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func decodePolicy(data []byte) (*Policy, error) {
+	var policy Policy
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("unable to decode policy: %w", err)
+	}
+	return &policy, nil
+}
+
+func (a *policyAuthorizer) Close() {
+	if a.ticker == nil {
+		return
+	}
+	a.ticker.Stop()
+	a.stop <- true
+	close(a.stop)
+}
+
+// Authorize evaluates the rules of the policy in order, and returns the decision of the first
+// rule that matches. Health check APIs are always allowed.
+func (a *policyAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	if IsHealthCheckAPI(target.APIName) {
+		return resultAllow, nil
+	}
+
+	policy := a.policy.Load()
+	for i := range policy.rules {
+		rule := &policy.rules[i]
+		matches, err := rule.matches(ctx, claims, target)
+		if err != nil {
+			return Result{}, err
+		}
+		if matches {
+			a.logDecision(claims, target, rule.result, rule.name)
+			return rule.result, nil
+		}
+	}
+
+	var result Result
+	switch policy.defaultDecision {
+	case policyDecisionAllow:
+		result = resultAllow
+	case policyDecisionRoles:
+		var err error
+		if result, err = NewDefaultAuthorizer().Authorize(ctx, claims, target); err != nil {
+			return Result{}, err
+		}
+	default:
+		result = resultDeny
+	}
+	a.logDecision(claims, target, result, "")
+	return result, nil
+}
+
+func (a *policyAuthorizer) logDecision(claims *Claims, target *CallTarget, result Result, rule string) {
+	if !a.config.LogDecisions {
+		return
+	}
+	decision := policyDecisionDeny
+	if result.Decision == DecisionAllow {
+		decision = policyDecisionAllow
+	}
+	var subject string
+	if claims != nil {
+		subject = claims.Subject
+	}
+	a.logger.Info("Authorization decision",
+		tag.NewStringTag("decision", decision),
+		tag.NewStringTag("policy-rule", rule),
+		tag.NewStringTag("subject", subject),
+		tag.NewStringTag("api", target.APIName),
+		tag.WorkflowNamespace(target.Namespace),
+		tag.WorkflowType(target.WorkflowType),
+		tag.WorkflowTaskQueueName(target.TaskQueue),
+	)
+}
+
+func (a *policyAuthorizer) timerCallback() {
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-a.ticker.C:
+		}
+		if err := a.reload(); err != nil {
+			a.logger.Error("error while reloading authorization policy, keeping the previous policy", tag.Error(err))
+		}
+	}
+}
+
+// reload loads the policy file if it changed since the last successful load.
+func (a *policyAuthorizer) reload() error {
+	info, err := os.Stat(a.config.PolicyFile)
+	if err != nil {
+		return fmt.Errorf("authorization policy file: %w", err)
+	}
+	if !info.ModTime().After(a.modTime) && a.policy.Load() != nil {
+		return nil
+	}
+	data, err := os.ReadFile(a.config.PolicyFile)
+	if err != nil {
+		return fmt.Errorf("authorization policy file: %w", err)
+	}
+	policy, err := decodePolicy(data)
+	if err != nil {
+		return err
+	}
+	compiled, err := compilePolicy(policy)
+	if err != nil {
+		return err
+	}
+	a.policy.Store(compiled)
+	a.modTime = info.ModTime()
+	a.logger.Info("Loaded authorization policy", tag.NewStringTag("policy-file", a.config.PolicyFile), tag.Counter(len(compiled.rules)))
+	return nil
+}
+
+func compilePolicy(policy *Policy) (*compiledPolicy, error) {
+	compiled := &compiledPolicy{defaultDecision: strings.ToLower(policy.DefaultDecision)}
+	switch compiled.defaultDecision {
+	case "":
+		compiled.defaultDecision = policyDecisionDeny
+	case policyDecisionAllow, policyDecisionDeny, policyDecisionRoles:
+	default:
+		return nil, fmt.Errorf("invalid policy default decision %q", policy.DefaultDecision)
+	}
+	for i, rule := range policy.Rules {
+		compiledRule, err := compileRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("policy rule %d (%q): %w", i, rule.Name, err)
+		}
+		compiled.rules = append(compiled.rules, compiledRule)
+	}
+	return compiled, nil
+}
+
+func compileRule(rule PolicyRule) (compiledRule, error) {
+	compiled := compiledRule{name: rule.Name}
+	switch strings.ToLower(rule.Effect) {
+	case policyDecisionAllow:
+		compiled.result = resultAllow
+	case policyDecisionDeny:
+		compiled.result = Result{Decision: DecisionDeny, Reason: fmt.Sprintf("denied by policy rule %q", rule.Name)}
+	default:
+		return compiledRule{}, fmt.Errorf("invalid effect %q, must be allow or deny", rule.Effect)
+	}
+	for _, role := range rule.Claims.Roles {
+		r, err := parsePolicyRole(role)
+		if err != nil {
+			return compiledRule{}, err
+		}
+		compiled.roles |= r
+	}
+	var err error
+	if compiled.apis, err = compilePatterns(rule.APIs); err != nil {
+		return compiledRule{}, err
+	}
+	if compiled.namespaces, err = compilePatterns(rule.Namespaces); err != nil {
+		return compiledRule{}, err
+	}
+	if compiled.workflowTypes, err = compilePatterns(rule.WorkflowTypes); err != nil {
+		return compiledRule{}, err
+	}
+	if compiled.taskQueues, err = compilePatterns(rule.TaskQueues); err != nil {
+		return compiledRule{}, err
+	}
+	if compiled.subjects, err = compilePatterns(rule.Claims.Subjects); err != nil {
+		return compiledRule{}, err
+	}
+	return compiled, nil
+}
+
+func parsePolicyRole(role string) (Role, error) {
+	switch strings.ToLower(role) {
+	case "worker":
+		return RoleWorker, nil
+	case "reader":
+		return RoleReader, nil
+	case "writer":
+		return RoleWriter, nil
+	case "admin":
+		return RoleAdmin, nil
+	default:
+		return RoleUndefined, fmt.Errorf("invalid role %q", role)
+	}
+}
+
+// compilePatterns converts patterns with "*" wildcards to anchored regular expressions.
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	result := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		parts := strings.Split(pattern, "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		re, err := regexp.Compile("^" + strings.Join(parts, ".*") + "$")
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, re)
+	}
+	return result, nil
+}
+
+func matchesAny(patterns []*regexp.Regexp, values ...string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, re := range patterns {
+		for _, value := range values {
+			if re.MatchString(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *compiledRule) matches(ctx context.Context, claims *Claims, target *CallTarget) (bool, error) {
+	methodName := target.APIName[strings.LastIndex(target.APIName, "/")+1:]
+	if !matchesAny(r.apis, target.APIName, methodName) ||
+		!matchesAny(r.namespaces, target.Namespace) ||
+		!matchesAny(r.taskQueues, target.TaskQueue) {
+		return false, nil
+	}
+	if len(r.subjects) > 0 || r.roles != RoleUndefined {
+		if claims == nil || !matchesAny(r.subjects, claims.Subject) {
+			return false, nil
+		}
+		if r.roles != RoleUndefined && (claims.System|claims.Namespaces[target.Namespace])&r.roles == 0 {
+			return false, nil
+		}
+	}
+	// check the workflow type last since it may need a lookup
+	if len(r.workflowTypes) > 0 {
+		workflowTypes, err := target.ResolveWorkflowTypes(ctx)
+		if err != nil {
+			return false, err
+		}
+		// an allow rule has to match every targeted workflow type and a deny rule any of them,
+		// so that SignalWithStart can't reach an existing execution the rule doesn't cover
+		if r.result.Decision == DecisionAllow {
+			for _, workflowType := range workflowTypes {
+				if !matchesAny(r.workflowTypes, workflowType) {
+					return false, nil
+				}
+			}
+		} else if !matchesAny(r.workflowTypes, workflowTypes...) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
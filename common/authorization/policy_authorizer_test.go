@@ -0,0 +1,260 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+const testPolicy = `
+defaultDecision: roles
+rules:
+  - name: team-a-billing
+    effect: allow
+    apis: [SignalWorkflowExecution, SignalWithStartWorkflowExecution, TerminateWorkflowExecution]
+    workflowTypes: ["billing-*"]
+    claims:
+      subjects: ["team-a:*"]
+  - name: team-a-other
+    effect: deny
+    apis: [SignalWorkflowExecution, SignalWithStartWorkflowExecution, TerminateWorkflowExecution]
+    claims:
+      subjects: ["team-a:*"]
+  - name: no-queries-for-readers
+    effect: deny
+    apis: [QueryWorkflow]
+    claims:
+      roles: [reader]
+  - name: no-system-queue
+    effect: deny
+    namespaces: ["prod-*"]
+    taskQueues: ["system-*"]
+`
+
+type (
+	policyAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		policyFile string
+		authorizer *policyAuthorizer
+	}
+)
+
+func TestPolicyAuthorizerSuite(t *testing.T) {
+	s := new(policyAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *policyAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.policyFile = filepath.Join(s.T().TempDir(), "policy.yaml")
+	s.NoError(os.WriteFile(s.policyFile, []byte(testPolicy), 0o644))
+
+	authorizer, err := NewPolicyAuthorizer(&config.PolicyAuthorizer{
+		PolicyFile:      s.policyFile,
+		RefreshInterval: 10 * time.Millisecond,
+		LogDecisions:    true,
+	}, log.NewNoopLogger())
+	s.NoError(err)
+	s.authorizer = authorizer.(*policyAuthorizer)
+}
+
+func (s *policyAuthorizerSuite) TearDownTest() {
+	s.authorizer.Close()
+}
+
+func (s *policyAuthorizerSuite) TestWorkflowTypeRules() {
+	teamA := &Claims{Subject: "team-a:alice", Namespaces: map[string]Role{testNamespace: RoleWriter}}
+	signal := "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"
+
+	result, err := s.authorizer.Authorize(context.Background(), teamA, &CallTarget{
+		APIName:      signal,
+		Namespace:    testNamespace,
+		WorkflowType: "billing-invoice",
+	})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = s.authorizer.Authorize(context.Background(), teamA, &CallTarget{
+		APIName:      signal,
+		Namespace:    testNamespace,
+		WorkflowType: "shipping",
+	})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	s.Contains(result.Reason, "team-a-other")
+
+	// other subjects fall back to roles
+	result, err = s.authorizer.Authorize(context.Background(), &claimsNamespaceWriter, &CallTarget{
+		APIName:      signal,
+		Namespace:    testNamespace,
+		WorkflowType: "shipping",
+	})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestWorkflowTypeLookup() {
+	teamA := &Claims{Subject: "team-a:alice"}
+	lookups := 0
+	target := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution",
+		Namespace: testNamespace,
+		workflowTypeLookup: func(context.Context) (string, error) {
+			lookups++
+			return "billing-invoice", nil
+		},
+	}
+	result, err := s.authorizer.Authorize(context.Background(), teamA, target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+	s.Equal(1, lookups)
+
+	// lookup isn't needed if no rule with a workflow type condition is otherwise matched
+	target = &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow",
+		Namespace: testNamespace,
+		workflowTypeLookup: func(context.Context) (string, error) {
+			return "", errors.New("unexpected lookup")
+		},
+	}
+	result, err = s.authorizer.Authorize(context.Background(), &claimsNamespaceReader, target)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestWorkflowTypeLookup_SignalWithStart() {
+	teamA := &Claims{Subject: "team-a:alice"}
+	signalWithStart := func(existingWorkflowType string) *CallTarget {
+		return &CallTarget{
+			APIName:      "/temporal.api.workflowservice.v1.WorkflowService/SignalWithStartWorkflowExecution",
+			Namespace:    testNamespace,
+			WorkflowType: "billing-invoice",
+			workflowTypeLookup: func(context.Context) (string, error) {
+				return existingWorkflowType, nil
+			},
+		}
+	}
+
+	// no existing execution
+	result, err := s.authorizer.Authorize(context.Background(), teamA, signalWithStart(""))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = s.authorizer.Authorize(context.Background(), teamA, signalWithStart("billing-refund"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	// the existing execution isn't covered by the allow rule
+	result, err = s.authorizer.Authorize(context.Background(), teamA, signalWithStart("shipping"))
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	s.Contains(result.Reason, "team-a-other")
+}
+
+func (s *policyAuthorizerSuite) TestRoleAndTaskQueueRules() {
+	query := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow",
+		Namespace: testNamespace,
+	}
+	result, err := s.authorizer.Authorize(context.Background(), &claimsNamespaceReader, query)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	result, err = s.authorizer.Authorize(context.Background(), &claimsNamespaceWriter, query)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = s.authorizer.Authorize(context.Background(), &claimsSystemAdmin, &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+		Namespace: "prod-1",
+		TaskQueue: "system-tq",
+	})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestHealthCheck() {
+	result, err := s.authorizer.Authorize(context.Background(), nil, &targetGrpcHealthCheck)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestReload() {
+	start := &CallTarget{
+		APIName:   "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution",
+		Namespace: testNamespace,
+	}
+	result, err := s.authorizer.Authorize(context.Background(), &claimsNone, start)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	// invalid policy keeps the previous one
+	s.writePolicy("defaultDecision: maybe")
+	time.Sleep(50 * time.Millisecond)
+	result, err = s.authorizer.Authorize(context.Background(), &claimsNone, start)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	s.writePolicy("defaultDecision: allow")
+	s.Eventually(func() bool {
+		result, err := s.authorizer.Authorize(context.Background(), &claimsNone, start)
+		return err == nil && result.Decision == DecisionAllow
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *policyAuthorizerSuite) TestLoadPolicyErrors() {
+	_, err := LoadPolicy([]byte("rules:\n  - effect: maybe\n"))
+	s.ErrorContains(err, "invalid effect")
+	_, err = LoadPolicy([]byte("rules:\n  - effect: allow\n    claims:\n      roles: [owner]\n"))
+	s.ErrorContains(err, "invalid role")
+	_, err = LoadPolicy([]byte("rules:\n  - effect: allow\n    api: [StartWorkflowExecution]\n"))
+	s.ErrorContains(err, "field api not found")
+}
+
+func (s *policyAuthorizerSuite) TestGetAuthorizerFromConfigPolicy() {
+	cfg := config.Authorization{Authorizer: "policy", Policy: config.PolicyAuthorizer{PolicyFile: s.policyFile}}
+	auth, err := GetAuthorizerFromConfig(&cfg, log.NewNoopLogger())
+	s.NoError(err)
+	s.IsType(&policyAuthorizer{}, auth)
+}
+
+// writePolicy writes the policy file with a modification time that is later than the last load.
+func (s *policyAuthorizerSuite) writePolicy(policy string) {
+	s.NoError(os.WriteFile(s.policyFile, []byte(policy), 0o644))
+	modTime := time.Now().Add(time.Hour)
+	s.NoError(os.Chtimes(s.policyFile, modTime, modTime))
+}
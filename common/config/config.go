@@ -63,9 +63,10 @@ type (
 		Archival Archival `yaml:"archival"`
 		// PublicClient is config for connecting to temporal frontend
 		PublicClient PublicClient `yaml:"publicClient"`
-		// DynamicConfigClient is the config for setting up the file based dynamic config client
-		// Filepath should be relative to the root directory
-		DynamicConfigClient *dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// DynamicConfigClient is the config for setting up the dynamic config client.
+		// By default the file based client is used and Filepath should be relative to the root directory.
+		// Set Remote to get dynamic config from an HTTP endpoint instead.
+		DynamicConfigClient *dynamicconfig.ClientConfig `yaml:"dynamicConfigClient"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 		// ExporterConfig allows the specification of process-wide OTEL exporters
@@ -459,9 +460,10 @@ type (
 
 	// HistoryArchiverProvider contains the config for all history archivers
 	HistoryArchiverProvider struct {
-		Filestore *FilestoreArchiver `yaml:"filestore"`
-		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
-		S3store   *S3Archiver        `yaml:"s3store"`
+		Filestore  *FilestoreArchiver  `yaml:"filestore"`
+		Gstorage   *GstorageArchiver   `yaml:"gstorage"`
+		S3store    *S3Archiver         `yaml:"s3store"`
+		Jsonlstore *JsonlstoreArchiver `yaml:"jsonlstore"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -476,9 +478,10 @@ type (
 
 	// VisibilityArchiverProvider contains the config for all visibility archivers
 	VisibilityArchiverProvider struct {
-		Filestore *FilestoreArchiver `yaml:"filestore"`
-		S3store   *S3Archiver        `yaml:"s3store"`
-		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Filestore  *FilestoreArchiver  `yaml:"filestore"`
+		S3store    *S3Archiver         `yaml:"s3store"`
+		Gstorage   *GstorageArchiver   `yaml:"gstorage"`
+		Jsonlstore *JsonlstoreArchiver `yaml:"jsonlstore"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -487,6 +490,14 @@ type (
 		DirMode  string `yaml:"dirMode"`
 	}
 
+	// JsonlstoreArchiver contains the config for the partitioned JSON Lines archiver
+	JsonlstoreArchiver struct {
+		FileMode string `yaml:"fileMode"`
+		DirMode  string `yaml:"dirMode"`
+		// Compression is the codec used for archived files: "gzip" (default) or "none"
+		Compression string `yaml:"compression"`
+	}
+
 	// GstorageArchiver contain the config for google storage archiver
 	GstorageArchiver struct {
 		CredentialsPath string `yaml:"credentialsPath"`
@@ -563,14 +574,111 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer or "policy" for policyAuthorizer
 		Authorizer string `yaml:"authorizer"`
-		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
+		// Empty string for noopClaimMapper, "default" for defaultJWTClaimMapper or "certificate" for certificateClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
 		// Name of main auth header to pass to ClaimMapper (as `AuthToken`). Defaults to `authorization`.
 		AuthHeaderName string `yaml:"authHeaderName"`
 		// Name of extra auth header to pass to ClaimMapper (as `ExtraData`). Defaults to `authorization-extras`.
 		AuthExtraHeaderName string `yaml:"authExtraHeaderName"`
+		// Config for policyAuthorizer
+		Policy PolicyAuthorizer `yaml:"policy"`
+		// Identity providers trusted by defaultJWTClaimMapper. Tokens are matched to an issuer by
+		// their "iss" claim. Tokens from other issuers are validated with JWTKeyProvider and
+		// PermissionsClaimName if JWTKeyProvider has key source URIs, and rejected otherwise.
+		Issuers []JWTIssuer `yaml:"issuers"`
+		// Config for certificateClaimMapper
+		CertificateClaimMapper CertificateClaimMapper `yaml:"certificateClaimMapper"`
+		// Config for audit logging of authorization decisions
+		Audit AuthorizationAudit `yaml:"audit"`
+	}
+
+	// AuthorizationAudit contains the config for audit logging of authorization decisions
+	AuthorizationAudit struct {
+		// Where audit records are written as JSON lines: "stdout" or "file".
+		// Empty string disables audit logging.
+		Sink string `yaml:"sink"`
+		// Path of the audit log for the "file" sink. Records are appended to the file.
+		File string `yaml:"file"`
+		// Number of records that are buffered while they are written in the background.
+		// Records are dropped if the buffer is full, which is reported by the
+		// service_authorization_audit_dropped metric. Defaults to 10000.
+		QueueSize int `yaml:"queueSize"`
+		// Fraction of allowed calls that are recorded per API, keyed by full API name or method
+		// name, e.g. "PollWorkflowTaskQueue": 0.01. Calls to other APIs and denied calls are
+		// always recorded.
+		SampleRates map[string]float64 `yaml:"sampleRates"`
+		// Whether records include the request. Payloads are always redacted from recorded requests.
+		IncludeRequest bool `yaml:"includeRequest"`
+		// Names of additional request fields to redact from recorded requests, e.g. "identity".
+		// Fields are matched by proto field name at any depth.
+		RedactFields []string `yaml:"redactFields"`
+	}
+
+	// CertificateClaimMapper contains the config for the claim mapper that maps the identities in
+	// mTLS client certificates to roles
+	CertificateClaimMapper struct {
+		// Certificate field used as the claims subject, one of the fields supported by
+		// CertificateClaimRule. Defaults to the SPIFFE ID if the certificate has one and to the
+		// subject common name otherwise.
+		SubjectField string `yaml:"subjectField"`
+		// Rules are evaluated in order and the permissions of every matching rule are granted
+		Rules []CertificateClaimRule `yaml:"rules"`
+	}
+
+	// CertificateClaimRule grants permissions to client certificates with a matching field
+	CertificateClaimRule struct {
+		// Certificate field to match: "commonName", "organization", "organizationalUnit",
+		// "dnsName", "uri", "emailAddress" or "spiffeID". Fields with multiple values match if
+		// any value matches.
+		Field string `yaml:"field"`
+		// Pattern for the field value, "*" matches any sequence of characters other than
+		// WildcardSeparators
+		Pattern string `yaml:"pattern"`
+		// Characters that "*" in Pattern doesn't match. Defaults to "/:@", so that a wildcard
+		// matches within a single segment of a URI or the local part of an email address. Set
+		// to "." to match within a single label of a domain name, or to "" to match anything.
+		WildcardSeparators *string `yaml:"wildcardSeparators"`
+		// Permissions granted in "<namespace>:<permission>" format. "$1", "$2", ... in the
+		// namespace are replaced with the text matched by the wildcards of Pattern, e.g.
+		// pattern "spiffe://example.org/ns/*/worker" with permission "$1:worker". Permissions on
+		// the system namespace are only granted if the rule names it literally.
+		Permissions []string `yaml:"permissions"`
+	}
+
+	// PolicyAuthorizer contains the config for the policy-driven authorizer
+	PolicyAuthorizer struct {
+		// Path of the YAML file with the authorization policy
+		PolicyFile string `yaml:"policyFile"`
+		// How often the policy file is checked for changes. Zero disables reloading.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// If true, every authorization decision is logged, with the rule that made it
+		LogDecisions bool `yaml:"logDecisions"`
+	}
+
+	// JWTIssuer contains the config for an identity provider trusted by defaultJWTClaimMapper
+	JWTIssuer struct {
+		// Issuer must match the "iss" claim of tokens. Unless KeySourceURIs is set, signing keys
+		// are loaded from the jwks_uri in the OpenID Connect discovery document at
+		// <Issuer>/.well-known/openid-configuration. Discovery doesn't block startup: it's
+		// retried in the background, and tokens of the issuer are rejected until it succeeds.
+		Issuer string `yaml:"issuer"`
+		// JWKS URIs to load signing keys from, instead of using discovery
+		KeySourceURIs []string `yaml:"keySourceURIs"`
+		// How often signing keys (and the discovery document) are reloaded. Zero disables reloading.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// If set, the "aud" claim of tokens must contain one of these audiences
+		Audiences []string `yaml:"audiences"`
+		// Claim with permissions in "<namespace>:<permission>" format, e.g. "accounting:write"
+		// or "temporal-system:admin". Nested claims can be selected with a dotted path, e.g.
+		// "realm_access.roles". Defaults to "permissions".
+		PermissionsClaimName string `yaml:"permissionsClaimName"`
+		// Claim with group names that are mapped to permissions with GroupPermissions.
+		// Nested claims can be selected with a dotted path.
+		GroupsClaimName string `yaml:"groupsClaimName"`
+		// Maps group names to permissions in "<namespace>:<permission>" format
+		GroupPermissions map[string][]string `yaml:"groupPermissions"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -25,9 +25,12 @@
 package dynamicconfig
 
 import (
+	"errors"
+
 	enumspb "go.temporal.io/api/enums/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log"
 )
 
 type (
@@ -60,6 +63,13 @@ type (
 		Subscribe(update ClientUpdateFunc) (cancel func())
 	}
 
+	// ClientConfig is the config for the dynamic config client set up from the static server config.
+	// The file based client is used unless Remote is set.
+	ClientConfig struct {
+		FileBasedClientConfig `yaml:",inline"`
+		Remote                *RemoteClientConfig `yaml:"remote"`
+	}
+
 	// Called with modified keys on any change to the current value set.
 	// Deleted keys/constraints will get a nil value.
 	ClientUpdateFunc func(map[Key][]ConstrainedValue)
@@ -79,7 +89,9 @@ type (
 	// type, it will be ignored.
 	ConstrainedValue struct {
 		Constraints Constraints
-		Value       any
+		// Rollout optionally restricts the value to a subset of hosts.
+		Rollout RolloutConstraints
+		Value   any
 	}
 	TypedConstrainedValue[T any] struct {
 		Constraints Constraints
@@ -117,8 +129,52 @@ type (
 		TaskType      enumsspb.TaskType
 		Destination   string
 	}
+
+	// RolloutConstraints restrict a ConstrainedValue to a subset of hosts, to allow staged
+	// rollout of dynamic config changes. Unlike Constraints, they are not part of the
+	// precedence order: each host evaluates them against its own identity, so they can be used
+	// with any key and combined with any Constraints.
+	//
+	// A value with RolloutConstraints is used only on hosts that match all of the fields that
+	// are set, and takes priority over a value with the same Constraints and no
+	// RolloutConstraints. To target several services or hosts, use several ConstrainedValues.
+	RolloutConstraints struct {
+		// Service is a service name, e.g. "history" or "matching".
+		Service string
+		// Host is a host identity. By default, this is the hostname.
+		Host string
+		// Percentage selects a stable subset of hosts: a host is included if a hash of its
+		// service name and identity, in [0, 100), is less than Percentage. Raising Percentage
+		// only adds hosts, so it can be raised step by step. Zero means all hosts.
+		Percentage int
+	}
+
+	// RolloutHost identifies the host that a Collection evaluates RolloutConstraints for.
+	RolloutHost struct {
+		Service  string
+		Identity string
+	}
 )
 
 func (k Key) String() string {
 	return string(k)
 }
+
+// NewClientFromConfig creates the dynamic config client selected by config.
+func NewClientFromConfig(config *ClientConfig, logger log.Logger, doneCh <-chan interface{}) (Client, error) {
+	if config == nil {
+		return nil, errors.New("configuration for dynamic config client is nil")
+	}
+	if config.Remote != nil {
+		client, err := NewRemoteClient(config.Remote, logger, doneCh)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+	client, err := NewFileBasedClient(&config.FileBasedClientConfig, logger, doneCh)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -41,6 +42,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/pingable"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/util"
@@ -52,9 +54,12 @@ type (
 	// The rest of the server code should use Collection as the interface to dynamic config,
 	// instead of the low-level Client.
 	Collection struct {
-		client   Client
-		logger   log.Logger
-		errCount int64
+		client         Client
+		logger         log.Logger
+		metricsHandler metrics.Handler
+		host           RolloutHost
+		hostBucket     int
+		errCount       int64
 
 		cancelClientSubscription func()
 
@@ -64,6 +69,10 @@ type (
 		callbackPool     *goro.AdaptivePool
 
 		poller goro.Group
+
+		changeLock    sync.Mutex // protects lastValues and changeHistory
+		lastValues    map[Key][]ConstrainedValue
+		changeHistory []ChangeRecord
 	}
 
 	subscription[T any] struct {
@@ -103,6 +112,9 @@ type (
 
 const (
 	errCountLogThreshold = 1000
+
+	// maxChangeHistory is the number of change records kept in memory by a Collection.
+	maxChangeHistory = 100
 )
 
 var (
@@ -117,16 +129,27 @@ var (
 // NewCollection creates a new collection. For subscriptions to work, you must call Start/Stop.
 // Get will work without Start/Stop.
 func NewCollection(client Client, logger log.Logger) *Collection {
+	return NewCollectionForHost(client, logger, RolloutHost{})
+}
+
+// NewCollectionForHost creates a new collection that uses host to evaluate the
+// RolloutConstraints of values.
+func NewCollectionForHost(client Client, logger log.Logger, host RolloutHost) *Collection {
 	return &Collection{
-		client:        client,
-		logger:        logger,
-		errCount:      -1,
-		subscriptions: make(map[Key]map[int]any),
+		client:         client,
+		logger:         logger,
+		metricsHandler: metrics.NoopMetricsHandler,
+		host:           host,
+		hostBucket:     host.rolloutBucket(),
+		errCount:       -1,
+		subscriptions:  make(map[Key]map[int]any),
+		lastValues:     make(map[Key][]ConstrainedValue),
 	}
 }
 
 func (c *Collection) Start() {
 	s := DynamicConfigSubscriptionCallback.Get(c)()
+	c.snapshotValues()
 	c.subscriptionLock.Lock()
 	defer c.subscriptionLock.Unlock()
 	c.callbackPool = goro.NewAdaptivePool(clock.NewRealTimeSource(), s.MinWorkers, s.MaxWorkers, s.TargetDelay, s.ShrinkFactor)
@@ -176,6 +199,7 @@ func (c *Collection) pollForChanges(ctx context.Context) error {
 	for ctx.Err() == nil {
 		util.InterruptibleSleep(ctx, interval())
 		c.pollOnce()
+		c.recordPolledChanges()
 	}
 	return ctx.Err()
 }
@@ -200,6 +224,8 @@ func (c *Collection) pollOnce() {
 }
 
 func (c *Collection) keysChanged(changed map[Key][]ConstrainedValue) {
+	c.recordChanges(changed)
+
 	c.subscriptionLock.Lock()
 	defer c.subscriptionLock.Unlock()
 	if c.callbackPool == nil {
@@ -218,6 +244,123 @@ func (c *Collection) keysChanged(changed map[Key][]ConstrainedValue) {
 	}
 }
 
+// DescribeKey returns the current values of key, the effective value for the given
+// constraints, and the recorded changes of key.
+func (c *Collection) DescribeKey(key Key, cs Constraints) KeyDescription {
+	desc := KeyDescription{
+		Key:    canonicalKey(key),
+		Values: c.client.GetValue(key),
+	}
+	if setting := queryRegistry(key); setting != nil {
+		desc.Registered = true
+		desc.Precedence = setting.Precedence()
+		desc.EffectiveValue = setting.effectiveValue(c, cs)
+	}
+
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+	for _, record := range c.changeHistory {
+		var changes []ValueChange
+		for _, change := range record.Changes {
+			if change.Key == desc.Key {
+				changes = append(changes, change)
+			}
+		}
+		if len(changes) > 0 {
+			desc.History = append(desc.History, ChangeRecord{Time: record.Time, Changes: changes})
+		}
+	}
+	return desc
+}
+
+// ConfiguredKeys returns the sorted keys that currently have values, as of the last change
+// seen by the Collection. It is empty if the Collection is not started.
+func (c *Collection) ConfiguredKeys() []Key {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+	keys := make([]Key, 0, len(c.lastValues))
+	for key, cvs := range c.lastValues {
+		if len(cvs) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// ChangeHistory returns the most recent recorded changes of all keys, oldest first.
+func (c *Collection) ChangeHistory() []ChangeRecord {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+	return slices.Clone(c.changeHistory)
+}
+
+// snapshotValues records the current values of all registered keys, so that later changes
+// can be diffed against them.
+func (c *Collection) snapshotValues() {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+	for _, setting := range registeredSettings() {
+		if cvs := c.client.GetValue(setting.Key()); len(cvs) > 0 {
+			c.lastValues[setting.Key()] = cvs
+			c.recordRolloutState(setting.Key(), nil, cvs)
+		}
+	}
+}
+
+// recordPolledChanges is used for clients that don't notify about changes: it compares the
+// values of all registered keys with the last seen values.
+func (c *Collection) recordPolledChanges() {
+	changed := make(map[Key][]ConstrainedValue)
+	for _, setting := range registeredSettings() {
+		changed[setting.Key()] = c.client.GetValue(setting.Key())
+	}
+	c.recordChanges(changed)
+}
+
+func (c *Collection) recordChanges(changed map[Key][]ConstrainedValue) {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	var changes []ValueChange
+	for key, cvs := range changed {
+		key = canonicalKey(key)
+		changes = append(changes, DiffValues(key, c.lastValues[key], cvs)...)
+		c.recordRolloutState(key, c.lastValues[key], cvs)
+		if len(cvs) > 0 {
+			c.lastValues[key] = cvs
+		} else {
+			delete(c.lastValues, key)
+		}
+	}
+	if len(changes) == 0 {
+		return
+	}
+	slices.SortStableFunc(changes, func(a, b ValueChange) int { return strings.Compare(a.Key.String(), b.Key.String()) })
+
+	c.changeHistory = append(c.changeHistory, ChangeRecord{Time: time.Now(), Changes: changes})
+	if len(c.changeHistory) > maxChangeHistory {
+		c.changeHistory = slices.Delete(c.changeHistory, 0, len(c.changeHistory)-maxChangeHistory)
+	}
+
+	for _, change := range changes {
+		metrics.DynamicConfigValueChanges.With(c.metricsHandler).Record(
+			1,
+			metrics.StringTag("key", change.Key.String()),
+			metrics.StringTag("change_type", change.Type.String()),
+		)
+	}
+}
+
+// canonicalKey returns the registered spelling of key, or the lower-cased key if it's not
+// registered.
+func canonicalKey(key Key) Key {
+	if setting := queryRegistry(key); setting != nil {
+		return setting.Key()
+	}
+	return Key(strings.ToLower(key.String()))
+}
+
 func (c *Collection) throttleLog() bool {
 	// TODO: This is a lot of unnecessary contention with little benefit. Consider using
 	// https://github.com/cespare/percpu here.
@@ -269,6 +412,8 @@ func matchAndConvertCvs[T any](
 	precedence []Constraints,
 	cvs []ConstrainedValue,
 ) T {
+	cvs = c.applyRollout(cvs)
+
 	var defaultCVs []TypedConstrainedValue[T]
 	if cdef != nil {
 		defaultCVs = *cdef
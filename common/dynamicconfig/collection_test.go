@@ -25,11 +25,13 @@
 package dynamicconfig_test
 
 import (
+	"fmt"
 	"maps"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -667,3 +669,115 @@ func (s *subscriptionSuite) TestSubscriptionWithDefault() {
 	s.Equal(100, v)
 	s.Nil(cancel)
 }
+
+func TestChangeHistory(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	setting := dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyFilteredByNamespaceKey, 10, "")
+
+	client := newTestSubscribableClient()
+	client.Set(setting.Key(), []dynamicconfig.ConstrainedValue{{Value: 20}})
+	cln := dynamicconfig.NewCollection(client, log.NewNoopLogger())
+	cln.Start()
+	defer cln.Stop()
+
+	// values present at start are not changes
+	require.Empty(t, cln.ChangeHistory())
+	require.Equal(t, []dynamicconfig.Key{setting.Key()}, cln.ConfiguredKeys())
+
+	ns1 := dynamicconfig.Constraints{Namespace: "ns1"}
+	client.Set(setting.Key(), []dynamicconfig.ConstrainedValue{
+		{Value: 30},
+		{Constraints: ns1, Value: 40},
+	})
+	client.Set(setting.Key(), []dynamicconfig.ConstrainedValue{{Value: 30}})
+	client.SetValue(unknownKey, "abc")
+
+	history := cln.ChangeHistory()
+	require.Len(t, history, 3)
+	require.Equal(t, []dynamicconfig.ValueChange{
+		{Key: setting.Key(), Type: dynamicconfig.ValueChanged, OldValue: 20, NewValue: 30},
+		{Key: setting.Key(), Constraints: ns1, Type: dynamicconfig.ValueAdded, NewValue: 40},
+	}, history[0].Changes)
+	require.Equal(t, []dynamicconfig.ValueChange{
+		{Key: setting.Key(), Constraints: ns1, Type: dynamicconfig.ValueRemoved, OldValue: 40},
+	}, history[1].Changes)
+	require.Equal(t, []dynamicconfig.ValueChange{
+		{Key: "unknownkey", Type: dynamicconfig.ValueAdded, NewValue: "abc"},
+	}, history[2].Changes)
+	require.Equal(t, []dynamicconfig.Key{setting.Key(), "unknownkey"}, cln.ConfiguredKeys())
+
+	desc := cln.DescribeKey(setting.Key(), ns1)
+	require.True(t, desc.Registered)
+	require.Equal(t, dynamicconfig.PrecedenceNamespace, desc.Precedence)
+	require.Equal(t, 30, desc.EffectiveValue)
+	require.Equal(t, []dynamicconfig.ConstrainedValue{{Value: 30}}, desc.Values)
+	require.Equal(t, history[:2], desc.History)
+
+	desc = cln.DescribeKey(unknownKey, dynamicconfig.Constraints{})
+	require.False(t, desc.Registered)
+	require.Nil(t, desc.EffectiveValue)
+	require.Equal(t, history[2:], desc.History)
+}
+
+func TestDiffValues(t *testing.T) {
+	ns1 := dynamicconfig.Constraints{Namespace: "ns1"}
+	ns2 := dynamicconfig.Constraints{Namespace: "ns2"}
+	changes := dynamicconfig.DiffValues(
+		testGetIntPropertyKey,
+		[]dynamicconfig.ConstrainedValue{{Value: 1}, {Constraints: ns1, Value: 2}},
+		[]dynamicconfig.ConstrainedValue{{Value: 1}, {Constraints: ns2, Value: 3}},
+	)
+	require.Equal(t, []dynamicconfig.ValueChange{
+		{Key: testGetIntPropertyKey, Constraints: ns1, Type: dynamicconfig.ValueRemoved, OldValue: 2},
+		{Key: testGetIntPropertyKey, Constraints: ns2, Type: dynamicconfig.ValueAdded, NewValue: 3},
+	}, changes)
+	require.Empty(t, dynamicconfig.DiffValues(testGetIntPropertyKey, nil, nil))
+}
+
+func TestRolloutConstraints(t *testing.T) {
+	dynamicconfig.ResetRegistryForTest()
+	setting := dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyFilteredByNamespaceKey, 10, "")
+
+	client := newTestSubscribableClient()
+	historyHost := dynamicconfig.NewCollectionForHost(client, log.NewNoopLogger(),
+		dynamicconfig.RolloutHost{Service: "history", Identity: "host-1"})
+	matchingHost := dynamicconfig.NewCollectionForHost(client, log.NewNoopLogger(),
+		dynamicconfig.RolloutHost{Service: "matching", Identity: "host-2"})
+
+	client.Set(setting.Key(), []dynamicconfig.ConstrainedValue{
+		{Value: 20},
+		{Rollout: dynamicconfig.RolloutConstraints{Service: "history"}, Value: 30},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns1"}, Value: 40},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns1"}, Rollout: dynamicconfig.RolloutConstraints{Host: "host-2"}, Value: 50},
+	})
+	require.Equal(t, 30, setting.Get(historyHost)("ns2"))
+	require.Equal(t, 40, setting.Get(historyHost)("ns1"))
+	require.Equal(t, 20, setting.Get(matchingHost)("ns2"))
+	require.Equal(t, 50, setting.Get(matchingHost)("ns1"))
+
+	// raising the percentage only adds hosts
+	var hosts []*dynamicconfig.Collection
+	for i := 0; i < 100; i++ {
+		hosts = append(hosts, dynamicconfig.NewCollectionForHost(client, log.NewNoopLogger(),
+			dynamicconfig.RolloutHost{Service: "history", Identity: fmt.Sprintf("host-%d", i)}))
+	}
+	var prevIncluded map[int]bool
+	for _, pct := range []int{10, 50, 100} {
+		client.Set(setting.Key(), []dynamicconfig.ConstrainedValue{
+			{Value: 20},
+			{Rollout: dynamicconfig.RolloutConstraints{Percentage: pct}, Value: 30},
+		})
+		included := make(map[int]bool)
+		for i, host := range hosts {
+			if setting.Get(host)("ns1") == 30 {
+				included[i] = true
+			}
+		}
+		for i := range prevIncluded {
+			require.True(t, included[i], "host %d was removed from rollout at %d%%", i, pct)
+		}
+		require.InDelta(t, pct, len(included), 15)
+		prevIncluded = included
+	}
+	require.Len(t, prevIncluded, 100)
+}
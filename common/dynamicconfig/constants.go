@@ -1495,6 +1495,21 @@ If value less or equal to 0, will fall back to TaskSchedulerNamespaceMaxQPS`,
 		`TaskSchedulerNamespaceMaxQPS is the max qps task schedulers on a host can schedule tasks for a certain namespace
 If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS`,
 	)
+	TaskSchedulerNamespacePriorityTier = NewNamespaceStringSetting(
+		"history.taskSchedulerNamespacePriorityTier",
+		"",
+		`TaskSchedulerNamespacePriorityTier is the priority tier a namespace belongs to in the host level task scheduler.
+The round robin weights of the namespace's task channels are multiplied by the weight of the tier
+(see TaskSchedulerPriorityTierWeights). Namespaces without a tier belong to the "default" tier, and tiers
+without a configured weight use weight 1. Changes take effect without restarting the history service.`,
+	)
+	TaskSchedulerPriorityTierWeights = NewGlobalTypedSetting(
+		"history.taskSchedulerPriorityTierWeights",
+		map[string]int(nil),
+		`TaskSchedulerPriorityTierWeights is a map from priority tier name to the weight multiplier applied to the
+round robin weights of namespaces in that tier (see TaskSchedulerNamespacePriorityTier).
+Weights less than 1 are treated as 1. Example: {"critical": 10, "batch": 1}`,
+	)
 
 	TimerTaskBatchSize = NewGlobalIntSetting(
 		"history.timerTaskBatchSize",
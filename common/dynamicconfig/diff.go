@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"reflect"
+	"time"
+)
+
+type (
+	// ValueChangeType is the kind of change of a ConstrainedValue between two reloads.
+	ValueChangeType int
+
+	// ValueChange is a change of a single ConstrainedValue of a key. ConstrainedValues are
+	// identified by their constraints and rollout constraints, so a value is changed if both
+	// stay the same.
+	ValueChange struct {
+		Key         Key
+		Constraints Constraints
+		Rollout     RolloutConstraints
+		Type        ValueChangeType
+		// OldValue is nil if the value was added.
+		OldValue any
+		// NewValue is nil if the value was removed.
+		NewValue any
+	}
+
+	// ChangeRecord is a set of changes that took effect at the same time.
+	ChangeRecord struct {
+		Time    time.Time
+		Changes []ValueChange
+	}
+
+	// KeyDescription describes the current state of a key as seen by a Collection.
+	KeyDescription struct {
+		Key Key
+		// Registered is false for keys that are configured but not used by the server.
+		Registered bool
+		Precedence Precedence
+		// Values are the ConstrainedValues returned by the client.
+		Values []ConstrainedValue
+		// EffectiveValue is the value used by the server for the requested constraints, after
+		// applying precedence and defaults. It is nil for unregistered keys.
+		EffectiveValue any
+		// History are the recorded changes of this key, oldest first.
+		History []ChangeRecord
+	}
+)
+
+const (
+	ValueAdded ValueChangeType = iota + 1
+	ValueRemoved
+	ValueChanged
+)
+
+func (t ValueChangeType) String() string {
+	switch t {
+	case ValueAdded:
+		return "added"
+	case ValueRemoved:
+		return "removed"
+	case ValueChanged:
+		return "changed"
+	default:
+		return "unknown"
+	}
+}
+
+// DiffValues returns the changes between the old and new ConstrainedValues of key.
+func DiffValues(key Key, oldValues []ConstrainedValue, newValues []ConstrainedValue) []ValueChange {
+	var changes []ValueChange
+	for _, oldValue := range oldValues {
+		newValue, ok := findConstraints(newValues, oldValue.Constraints, oldValue.Rollout)
+		if !ok {
+			changes = append(changes, ValueChange{
+				Key:         key,
+				Constraints: oldValue.Constraints,
+				Rollout:     oldValue.Rollout,
+				Type:        ValueRemoved,
+				OldValue:    oldValue.Value,
+			})
+		} else if !reflect.DeepEqual(oldValue.Value, newValue.Value) {
+			changes = append(changes, ValueChange{
+				Key:         key,
+				Constraints: oldValue.Constraints,
+				Rollout:     oldValue.Rollout,
+				Type:        ValueChanged,
+				OldValue:    oldValue.Value,
+				NewValue:    newValue.Value,
+			})
+		}
+	}
+	for _, newValue := range newValues {
+		if _, ok := findConstraints(oldValues, newValue.Constraints, newValue.Rollout); !ok {
+			changes = append(changes, ValueChange{
+				Key:         key,
+				Constraints: newValue.Constraints,
+				Rollout:     newValue.Rollout,
+				Type:        ValueAdded,
+				NewValue:    newValue.Value,
+			})
+		}
+	}
+	return changes
+}
+
+func findConstraints(cvs []ConstrainedValue, constraints Constraints, rollout RolloutConstraints) (ConstrainedValue, bool) {
+	for _, cv := range cvs {
+		if cv.Constraints == constraints && cv.Rollout == rollout {
+			return cv, true
+		}
+	}
+	return ConstrainedValue{}, false
+}
@@ -30,7 +30,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"reflect"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -204,7 +203,7 @@ func (fc *fileBasedClient) Update() error {
 
 	prev := fc.values.Swap(newValues)
 	oldValues, _ := prev.(configValueMap)
-	changedMap := fc.diffAndLog(oldValues, newValues)
+	changedMap := diffAndLog(fc.logger, oldValues, newValues)
 	fc.logger.Info("Updated dynamic config")
 
 	if len(changedMap) == 0 {
@@ -262,7 +261,7 @@ func loadFile(contents []byte) (configValueMap, *LoadResult) {
 			}
 
 			cvs[i].Value = val
-			cvs[i].Constraints = convertYamlConstraints(key, cv.Constraints, precedence, lr)
+			cvs[i].Constraints, cvs[i].Rollout = convertYamlConstraints(key, cv.Constraints, precedence, lr)
 		}
 		newValues[strings.ToLower(key)] = cvs
 	}
@@ -283,31 +282,24 @@ func (fc *fileBasedClient) validateStaticConfig(config *FileBasedClientConfig) e
 	return nil
 }
 
-func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) map[Key][]ConstrainedValue {
+func diffAndLog(logger log.Logger, old configValueMap, new configValueMap) map[Key][]ConstrainedValue {
 	changedMap := make(map[Key][]ConstrainedValue)
 
 	for key, newValues := range new {
-		oldValues, ok := old[key]
-		if !ok {
-			for _, newValue := range newValues {
-				// new key added
-				fc.diffAndLogValue(key, nil, &newValue)
-			}
+		changes := DiffValues(Key(key), old[key], newValues)
+		for _, change := range changes {
+			logValueChange(logger, change)
+		}
+		if len(changes) > 0 {
 			changedMap[Key(key)] = newValues
-		} else {
-			// compare existing keys
-			changed := fc.diffAndLogConstraints(key, oldValues, newValues)
-			if changed {
-				changedMap[Key(key)] = newValues
-			}
 		}
 	}
 
-	// check for removed values
+	// check for removed keys
 	for key, oldValues := range old {
 		if _, ok := new[key]; !ok {
-			for _, oldValue := range oldValues {
-				fc.diffAndLogValue(key, &oldValue, nil)
+			for _, change := range DiffValues(Key(key), oldValues, nil) {
+				logValueChange(logger, change)
 			}
 			changedMap[Key(key)] = nil
 		}
@@ -316,53 +308,27 @@ func (fc *fileBasedClient) diffAndLog(old configValueMap, new configValueMap) ma
 	return changedMap
 }
 
-func (fc *fileBasedClient) diffAndLogConstraints(key string, oldValues []ConstrainedValue, newValues []ConstrainedValue) bool {
-	changed := false
-	for _, oldValue := range oldValues {
-		matchFound := false
-		for _, newValue := range newValues {
-			if oldValue.Constraints == newValue.Constraints {
-				matchFound = true
-				if !reflect.DeepEqual(oldValue.Value, newValue.Value) {
-					fc.diffAndLogValue(key, &oldValue, &newValue)
-					changed = true
-				}
-			}
-		}
-		if !matchFound {
-			fc.diffAndLogValue(key, &oldValue, nil)
-			changed = true
-		}
+func logValueChange(logger log.Logger, change ValueChange) {
+	var oldValue, newValue *ConstrainedValue
+	if change.Type != ValueAdded {
+		oldValue = &ConstrainedValue{Constraints: change.Constraints, Rollout: change.Rollout, Value: change.OldValue}
 	}
-
-	for _, newValue := range newValues {
-		matchFound := false
-		for _, oldValue := range oldValues {
-			if oldValue.Constraints == newValue.Constraints {
-				matchFound = true
-			}
-		}
-		if !matchFound {
-			fc.diffAndLogValue(key, nil, &newValue)
-			changed = true
-		}
+	if change.Type != ValueRemoved {
+		newValue = &ConstrainedValue{Constraints: change.Constraints, Rollout: change.Rollout, Value: change.NewValue}
 	}
-	return changed
-}
 
-func (fc *fileBasedClient) diffAndLogValue(key string, oldValue *ConstrainedValue, newValue *ConstrainedValue) {
 	logLine := &strings.Builder{}
 	logLine.Grow(128)
 	logLine.WriteString("dynamic config changed for the key: ")
-	logLine.WriteString(key)
+	logLine.WriteString(change.Key.String())
 	logLine.WriteString(" oldValue: ")
-	fc.appendConstrainedValue(logLine, oldValue)
+	appendConstrainedValue(logLine, oldValue)
 	logLine.WriteString(" newValue: ")
-	fc.appendConstrainedValue(logLine, newValue)
-	fc.logger.Info(logLine.String())
+	appendConstrainedValue(logLine, newValue)
+	logger.Info(logLine.String())
 }
 
-func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
+func appendConstrainedValue(logLine *strings.Builder, value *ConstrainedValue) {
 	if value == nil {
 		logLine.WriteString("nil")
 	} else {
@@ -388,6 +354,15 @@ func (fc *fileBasedClient) appendConstrainedValue(logLine *strings.Builder, valu
 		if value.Constraints.Destination != "" {
 			logLine.WriteString(fmt.Sprintf("{Destination:%s}", value.Constraints.Destination))
 		}
+		if value.Rollout.Service != "" {
+			logLine.WriteString(fmt.Sprintf("{Service:%s}", value.Rollout.Service))
+		}
+		if value.Rollout.Host != "" {
+			logLine.WriteString(fmt.Sprintf("{Host:%s}", value.Rollout.Host))
+		}
+		if value.Rollout.Percentage != 0 {
+			logLine.WriteString(fmt.Sprintf("{Percentage:%d}", value.Rollout.Percentage))
+		}
 		logLine.WriteString(fmt.Sprint("} value: ", value.Value, " }"))
 	}
 }
@@ -431,8 +406,9 @@ func convertKeyTypeToStringSlice(s []interface{}) ([]interface{}, error) {
 	return stringKeySlice, nil
 }
 
-func convertYamlConstraints(key string, m map[string]any, precedence Precedence, lr *LoadResult) Constraints {
+func convertYamlConstraints(key string, m map[string]any, precedence Precedence, lr *LoadResult) (Constraints, RolloutConstraints) {
 	var cs Constraints
+	var rollout RolloutConstraints
 	for k, v := range m {
 		validConstraint := true
 		switch strings.ToLower(k) {
@@ -507,6 +483,24 @@ func convertYamlConstraints(key string, m map[string]any, precedence Precedence,
 				lr.errorf("destination constraint must be string")
 			}
 			validConstraint = precedence == PrecedenceDestination
+		case "service":
+			if v, ok := v.(string); ok {
+				rollout.Service = v
+			} else {
+				lr.errorf("service constraint must be string")
+			}
+		case "host":
+			if v, ok := v.(string); ok {
+				rollout.Host = v
+			} else {
+				lr.errorf("host constraint must be string")
+			}
+		case "percentage":
+			if v, ok := v.(int); ok && v > 0 && v <= 100 {
+				rollout.Percentage = v
+			} else {
+				lr.errorf("percentage constraint must be integer between 1 and 100")
+			}
 		default:
 			lr.errorf("unknown constraint type %q", k)
 		}
@@ -518,7 +512,7 @@ func convertYamlConstraints(key string, m map[string]any, precedence Precedence,
 			lr.warnf("constraint %q isn't valid for dynamic config key %q", k, key)
 		}
 	}
-	return cs
+	return cs, rollout
 }
 
 func (r *osReader) ReadFile() ([]byte, error) {
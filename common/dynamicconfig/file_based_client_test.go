@@ -621,6 +621,38 @@ testGetIntPropertyKey:
 	s.Equal(3, len(lr.Warnings))
 }
 
+func (s *fileBasedClientSuite) TestRolloutConstraints() {
+	dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetIntPropertyKey:
+- value: 1
+- value: 2
+  constraints:
+    namespace: samples-namespace
+    service: history
+    percentage: 10
+- value: 3
+  constraints:
+    host: host-1
+`))
+	s.Empty(lr.Errors)
+	s.Empty(lr.Warnings)
+}
+
+func (s *fileBasedClientSuite) TestErrorBadRolloutPercentage() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetIntPropertyKey:
+- value: 1
+  constraints:
+    percentage: 150
+`))
+	s.Equal(1, len(lr.Errors))
+	s.ErrorContains(lr.Errors[0], "percentage constraint must be integer between 1 and 100")
+}
+
 func (s *fileBasedClientSuite) TestErrorYamlDecode() {
 	lr := dynamicconfig.ValidateFile([]byte(`}}}}}}}}}`))
 	s.Equal(1, len(lr.Errors))
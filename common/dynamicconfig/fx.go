@@ -25,16 +25,34 @@
 package dynamicconfig
 
 import (
+	"os"
+
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/pingable"
+	"go.temporal.io/server/common/primitives"
 )
 
+type collectionParams struct {
+	fx.In
+
+	Client         Client
+	Logger         log.Logger
+	MetricsHandler metrics.Handler
+	ServiceName    primitives.ServiceName `optional:"true"`
+	Lifecycle      fx.Lifecycle
+}
+
 var Module = fx.Options(
-	fx.Provide(func(client Client, logger log.Logger, lc fx.Lifecycle) *Collection {
-		col := NewCollection(client, logger)
-		lc.Append(fx.StartStopHook(col.Start, col.Stop))
+	fx.Provide(func(params collectionParams) *Collection {
+		// errors are ignored: without a hostname, only service and percentage rollouts work
+		hostname, _ := os.Hostname()
+		host := RolloutHost{Service: string(params.ServiceName), Identity: hostname}
+		col := NewCollectionForHost(params.Client, params.Logger, host)
+		col.metricsHandler = params.MetricsHandler
+		params.Lifecycle.Append(fx.StartStopHook(col.Start, col.Stop))
 		return col
 	}),
 	fx.Provide(fx.Annotate(
@@ -62,6 +62,17 @@ func queryRegistry(k Key) GenericSetting {
 	return globalRegistry.settings[strings.ToLower(k.String())]
 }
 
+func registeredSettings() []GenericSetting {
+	if !globalRegistry.queried.Load() {
+		globalRegistry.queried.Store(true)
+	}
+	settings := make([]GenericSetting, 0, len(globalRegistry.settings))
+	for _, s := range globalRegistry.settings {
+		settings = append(settings, s)
+	}
+	return settings
+}
+
 // For testing only; do not call from regular code!
 func ResetRegistryForTest() {
 	globalRegistry.settings = nil
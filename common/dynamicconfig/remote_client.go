@@ -0,0 +1,331 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	expmaps "golang.org/x/exp/maps"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ Client = (*remoteClient)(nil)
+var _ NotifyingClient = (*remoteClient)(nil)
+
+const (
+	minRemotePollInterval     = time.Second
+	defaultRemotePollInterval = time.Second * 5
+	defaultLongPollTimeout    = time.Minute
+	remoteRequestTimeout      = time.Second * 10
+)
+
+type (
+	// RemoteClientConfig is the config for the remote dynamic config client. The client fetches
+	// dynamic config from an HTTP endpoint that serves the same YAML format as the file based client.
+	//
+	// The endpoint is expected to set an ETag header on responses. Subsequent requests send the last
+	// applied ETag in If-None-Match along with a "Prefer: wait=<seconds>" header, and the endpoint may
+	// hold the request until the config changes (long-poll), responding with 200 and the new config,
+	// or with 304 if nothing changed before the wait expired. Endpoints that don't support long-poll
+	// can respond immediately, in which case the client falls back to polling every PollInterval.
+	RemoteClientConfig struct {
+		// URL of the dynamic config endpoint.
+		URL string `yaml:"url"`
+		// Headers are added to every request, e.g. for authentication.
+		Headers map[string]string `yaml:"headers"`
+		// PollInterval is the minimum interval between two requests. Defaults to 5s.
+		PollInterval time.Duration `yaml:"pollInterval"`
+		// LongPollTimeout is how long the endpoint is asked to hold a request. Defaults to 1m.
+		LongPollTimeout time.Duration `yaml:"longPollTimeout"`
+		// CacheFilepath is where the last known good config is stored. If set, the client starts
+		// from this file when the endpoint is unavailable at startup.
+		CacheFilepath string `yaml:"cacheFilepath"`
+	}
+
+	remoteClient struct {
+		values     atomic.Value // configValueMap
+		logger     log.Logger
+		config     *RemoteClientConfig
+		httpClient *http.Client
+		doneCh     <-chan interface{}
+		etag       string
+
+		subscriptionLock sync.Mutex
+		subscriptionIdx  int
+		subscriptions    map[int]ClientUpdateFunc
+	}
+)
+
+var errRemoteConfigNotModified = errors.New("remote dynamic config not modified")
+
+// NewRemoteClient creates a client that gets dynamic config from a remote HTTP endpoint.
+func NewRemoteClient(config *RemoteClientConfig, logger log.Logger, doneCh <-chan interface{}) (*remoteClient, error) {
+	return NewRemoteClientWithHTTPClient(config, http.DefaultClient, logger, doneCh)
+}
+
+func NewRemoteClientWithHTTPClient(
+	config *RemoteClientConfig,
+	httpClient *http.Client,
+	logger log.Logger,
+	doneCh <-chan interface{},
+) (*remoteClient, error) {
+	if err := validateRemoteClientConfig(config); err != nil {
+		return nil, fmt.Errorf("unable to validate dynamic config: %w", err)
+	}
+	client := &remoteClient{
+		logger:        logger,
+		config:        config,
+		httpClient:    httpClient,
+		doneCh:        doneCh,
+		subscriptions: make(map[int]ClientUpdateFunc),
+	}
+
+	if err := client.init(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (rc *remoteClient) GetValue(key Key) []ConstrainedValue {
+	values := rc.values.Load().(configValueMap)
+	return values[strings.ToLower(key.String())]
+}
+
+func (rc *remoteClient) Subscribe(f ClientUpdateFunc) (cancel func()) {
+	rc.subscriptionLock.Lock()
+	defer rc.subscriptionLock.Unlock()
+
+	rc.subscriptionIdx++
+	id := rc.subscriptionIdx
+	rc.subscriptions[id] = f
+
+	return func() {
+		rc.subscriptionLock.Lock()
+		defer rc.subscriptionLock.Unlock()
+		delete(rc.subscriptions, id)
+	}
+}
+
+func (rc *remoteClient) init() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := rc.fetch(ctx, 0); err != nil {
+		cacheErr := rc.loadCache()
+		if cacheErr != nil {
+			cancel()
+			return fmt.Errorf("unable to read dynamic config: %w", errors.Join(err, cacheErr))
+		}
+		rc.logger.Warn("Unable to get dynamic config from remote endpoint, using last known good config.",
+			tag.Error(err), tag.NewStringTag("cache-file", rc.config.CacheFilepath))
+	}
+
+	go func() {
+		<-rc.doneCh
+		cancel()
+	}()
+	go rc.pollLoop(ctx)
+
+	return nil
+}
+
+func (rc *remoteClient) pollLoop(ctx context.Context) {
+	for ctx.Err() == nil {
+		start := time.Now()
+		err := rc.fetch(ctx, rc.config.LongPollTimeout)
+		if err != nil && ctx.Err() == nil {
+			rc.logger.Error("Unable to update dynamic config.", tag.Error(err))
+		}
+
+		// wait for the rest of the poll interval so that endpoints without long-poll support
+		// and failing endpoints don't get a request storm
+		timer := time.NewTimer(rc.config.PollInterval - time.Since(start))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}
+}
+
+// fetch gets the config from the remote endpoint and applies it. If wait is set, the endpoint is
+// asked to hold the request until the config changes or wait expires.
+func (rc *remoteClient) fetch(ctx context.Context, wait time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, wait+remoteRequestTimeout)
+	defer cancel()
+
+	contents, etag, err := rc.request(ctx, wait)
+	if errors.Is(err, errRemoteConfigNotModified) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := rc.update(contents); err != nil {
+		return err
+	}
+	rc.etag = etag
+
+	if err := rc.writeCache(contents); err != nil {
+		rc.logger.Warn("Unable to write dynamic config cache file.", tag.Error(err))
+	}
+	return nil
+}
+
+func (rc *remoteClient) request(ctx context.Context, wait time.Duration) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rc.config.URL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	for k, v := range rc.config.Headers {
+		req.Header.Set(k, v)
+	}
+	if rc.etag != "" {
+		req.Header.Set("If-None-Match", rc.etag)
+	}
+	if wait > 0 {
+		req.Header.Set("Prefer", fmt.Sprintf("wait=%d", int64(wait/time.Second)))
+	}
+
+	resp, err := rc.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("dynamic config endpoint: %s: %w", rc.config.URL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil, "", errRemoteConfigNotModified
+	default:
+		return nil, "", fmt.Errorf("dynamic config endpoint: %s: unexpected status %s", rc.config.URL, resp.Status)
+	}
+
+	contents, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("dynamic config endpoint: %s: %w", rc.config.URL, err)
+	}
+	return contents, resp.Header.Get("ETag"), nil
+}
+
+func (rc *remoteClient) update(contents []byte) error {
+	newValues, lr := loadFile(contents)
+	for _, e := range lr.Errors {
+		rc.logger.Warn("dynamic config error", tag.Error(e))
+	}
+	for _, w := range lr.Warnings {
+		rc.logger.Warn("dynamic config warning", tag.Error(w))
+	}
+	if len(lr.Errors) > 0 {
+		return fmt.Errorf("loading dynamic config failed: %d errors, %d warnings",
+			len(lr.Errors), len(lr.Warnings))
+	}
+
+	prev := rc.values.Swap(newValues)
+	oldValues, _ := prev.(configValueMap)
+	changedMap := diffAndLog(rc.logger, oldValues, newValues)
+	rc.logger.Info("Updated dynamic config")
+
+	if len(changedMap) == 0 {
+		return nil
+	}
+
+	rc.subscriptionLock.Lock()
+	subscriptions := expmaps.Values(rc.subscriptions)
+	rc.subscriptionLock.Unlock()
+
+	for _, update := range subscriptions {
+		update(changedMap)
+	}
+
+	return nil
+}
+
+func (rc *remoteClient) loadCache() error {
+	if rc.config.CacheFilepath == "" {
+		return errors.New("dynamic config cache file is not configured")
+	}
+	contents, err := os.ReadFile(rc.config.CacheFilepath)
+	if err != nil {
+		return fmt.Errorf("dynamic config cache file: %s: %w", rc.config.CacheFilepath, err)
+	}
+	return rc.update(contents)
+}
+
+// writeCache atomically replaces the cache file, so that a crash while writing never leaves a
+// partial config behind.
+func (rc *remoteClient) writeCache(contents []byte) error {
+	if rc.config.CacheFilepath == "" {
+		return nil
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(rc.config.CacheFilepath), filepath.Base(rc.config.CacheFilepath)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	if _, err := tmpFile.Write(contents); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), rc.config.CacheFilepath)
+}
+
+func validateRemoteClientConfig(config *RemoteClientConfig) error {
+	if config == nil {
+		return errors.New("configuration for dynamic config client is nil")
+	}
+	if config.URL == "" {
+		return errors.New("url for remote dynamic config is not set")
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultRemotePollInterval
+	}
+	if config.PollInterval < minRemotePollInterval {
+		return fmt.Errorf("poll interval should be at least %v", minRemotePollInterval)
+	}
+	if config.LongPollTimeout == 0 {
+		config.LongPollTimeout = defaultLongPollTimeout
+	}
+	if config.LongPollTimeout < 0 {
+		return errors.New("long poll timeout should not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	remoteClientSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		server   *stubConfigServer
+		cacheDir string
+		doneCh   chan interface{}
+	}
+
+	// stubConfigServer serves dynamic config with ETags and supports long-poll through the
+	// "Prefer: wait=<seconds>" header.
+	stubConfigServer struct {
+		*httptest.Server
+
+		lock      sync.Mutex
+		version   int
+		contents  string
+		status    int
+		changedCh chan struct{}
+		requests  []*http.Request
+	}
+)
+
+func TestRemoteClientSuite(t *testing.T) {
+	s := new(remoteClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *remoteClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.server = newStubConfigServer("testgetboolpropertykey:\n- value: true\n")
+	s.cacheDir = s.T().TempDir()
+	s.doneCh = make(chan interface{})
+}
+
+func (s *remoteClientSuite) TearDownTest() {
+	close(s.doneCh)
+	s.server.Close()
+}
+
+func (s *remoteClientSuite) newClient() (dynamicconfig.Client, error) {
+	return dynamicconfig.NewRemoteClient(&dynamicconfig.RemoteClientConfig{
+		URL:             s.server.URL,
+		Headers:         map[string]string{"Authorization": "Bearer token"},
+		PollInterval:    time.Second,
+		LongPollTimeout: time.Second * 10,
+		CacheFilepath:   filepath.Join(s.cacheDir, "dynamicconfig.yaml"),
+	}, log.NewNoopLogger(), s.doneCh)
+}
+
+func (s *remoteClientSuite) TestGetValue() {
+	client, err := s.newClient()
+	s.NoError(err)
+
+	values := client.GetValue("testGetBoolPropertyKey")
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: true}}, values)
+	s.Nil(client.GetValue("testGetIntPropertyKey"))
+
+	request := s.server.lastRequest()
+	s.Equal("Bearer token", request.Header.Get("Authorization"))
+	s.Empty(request.Header.Get("If-None-Match"))
+}
+
+func (s *remoteClientSuite) TestPushUpdate() {
+	client, err := s.newClient()
+	s.NoError(err)
+
+	updateCh := make(chan map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue, 1)
+	cancel := client.(dynamicconfig.NotifyingClient).Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+		updateCh <- changed
+	})
+	defer cancel()
+
+	// wait for the client to long-poll with the current ETag
+	s.Eventually(func() bool {
+		return s.server.lastRequest().Header.Get("Prefer") == "wait=10"
+	}, time.Second*5, time.Millisecond*50)
+	s.Equal(s.server.currentETag(), s.server.lastRequest().Header.Get("If-None-Match"))
+
+	s.server.setContents("testgetboolpropertykey:\n- value: false\ntestgetintpropertykey:\n- value: 10\n")
+	select {
+	case changed := <-updateCh:
+		s.Equal(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue{
+			"testgetboolpropertykey": {{Value: false}},
+			"testgetintpropertykey":  {{Value: 10}},
+		}, changed)
+	case <-time.After(time.Second * 5):
+		s.Fail("dynamic config update was not pushed")
+	}
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: false}}, client.GetValue("testGetBoolPropertyKey"))
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 10}}, client.GetValue("testGetIntPropertyKey"))
+}
+
+func (s *remoteClientSuite) TestInvalidUpdateKeepsLastKnownGood() {
+	client, err := s.newClient()
+	s.NoError(err)
+
+	requestCount := s.server.requestCount()
+	s.server.setContents("testgetboolpropertykey: [[[")
+	// the invalid config is not applied, so the client keeps asking for it
+	s.Eventually(func() bool {
+		return s.server.requestCount() >= requestCount+2
+	}, time.Second*5, time.Millisecond*50)
+
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: true}}, client.GetValue("testGetBoolPropertyKey"))
+	cached, err := os.ReadFile(filepath.Join(s.cacheDir, "dynamicconfig.yaml"))
+	s.NoError(err)
+	s.Equal("testgetboolpropertykey:\n- value: true\n", string(cached))
+}
+
+func (s *remoteClientSuite) TestStartFromCache() {
+	_, err := s.newClient()
+	s.NoError(err)
+
+	s.server.setStatus(http.StatusServiceUnavailable)
+	client, err := s.newClient()
+	s.NoError(err)
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: true}}, client.GetValue("testGetBoolPropertyKey"))
+}
+
+func (s *remoteClientSuite) TestStartWithoutCache() {
+	s.server.setStatus(http.StatusServiceUnavailable)
+	_, err := s.newClient()
+	s.Error(err)
+}
+
+func (s *remoteClientSuite) TestValidateConfig() {
+	_, err := dynamicconfig.NewRemoteClient(nil, nil, nil)
+	s.Error(err)
+
+	_, err = dynamicconfig.NewRemoteClient(&dynamicconfig.RemoteClientConfig{}, nil, nil)
+	s.Error(err)
+
+	_, err = dynamicconfig.NewRemoteClient(&dynamicconfig.RemoteClientConfig{
+		URL:          s.server.URL,
+		PollInterval: time.Millisecond,
+	}, nil, nil)
+	s.Error(err)
+}
+
+func (s *remoteClientSuite) TestClientConfig() {
+	var config dynamicconfig.ClientConfig
+	s.NoError(yaml.Unmarshal([]byte("filepath: config/testConfig.yaml\npollInterval: 10s\n"), &config))
+	s.Equal("config/testConfig.yaml", config.Filepath)
+	s.Equal(time.Second*10, config.PollInterval)
+	s.Nil(config.Remote)
+
+	config = dynamicconfig.ClientConfig{}
+	s.NoError(yaml.Unmarshal([]byte(fmt.Sprintf("remote:\n  url: %s\n  pollInterval: 1s\n", s.server.URL)), &config))
+	client, err := dynamicconfig.NewClientFromConfig(&config, log.NewNoopLogger(), s.doneCh)
+	s.NoError(err)
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: true}}, client.GetValue("testGetBoolPropertyKey"))
+}
+
+func newStubConfigServer(contents string) *stubConfigServer {
+	server := &stubConfigServer{
+		version:   1,
+		contents:  contents,
+		status:    http.StatusOK,
+		changedCh: make(chan struct{}),
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+func (s *stubConfigServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	s.requests = append(s.requests, r)
+	etag, changedCh := s.etagLocked(), s.changedCh
+	s.lock.Unlock()
+
+	if r.Header.Get("If-None-Match") == etag {
+		wait, _ := strconv.Atoi(strings.TrimPrefix(r.Header.Get("Prefer"), "wait="))
+		select {
+		case <-changedCh:
+		case <-time.After(time.Duration(wait) * time.Second):
+		case <-r.Context().Done():
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.status != http.StatusOK {
+		w.WriteHeader(s.status)
+		return
+	}
+	if r.Header.Get("If-None-Match") == s.etagLocked() {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("ETag", s.etagLocked())
+	_, _ = w.Write([]byte(s.contents))
+}
+
+func (s *stubConfigServer) setContents(contents string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.version++
+	s.contents = contents
+	close(s.changedCh)
+	s.changedCh = make(chan struct{})
+}
+
+func (s *stubConfigServer) setStatus(status int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.status = status
+}
+
+func (s *stubConfigServer) currentETag() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.etagLocked()
+}
+
+func (s *stubConfigServer) etagLocked() string {
+	return fmt.Sprintf(`"%d"`, s.version)
+}
+
+func (s *stubConfigServer) requestCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.requests)
+}
+
+func (s *stubConfigServer) lastRequest() *http.Request {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests[len(s.requests)-1]
+}
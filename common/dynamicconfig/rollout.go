@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"hash/fnv"
+	"slices"
+	"strings"
+
+	"go.temporal.io/server/common/metrics"
+)
+
+// IsZero returns true if r doesn't restrict the hosts that a value is used on.
+func (r RolloutConstraints) IsZero() bool {
+	return r == RolloutConstraints{}
+}
+
+func (r RolloutConstraints) matches(host RolloutHost, bucket int) bool {
+	if r.Service != "" && !strings.EqualFold(r.Service, host.Service) {
+		return false
+	}
+	if r.Host != "" && !strings.EqualFold(r.Host, host.Identity) {
+		return false
+	}
+	if r.Percentage > 0 && bucket >= r.Percentage {
+		return false
+	}
+	return true
+}
+
+// rolloutBucket returns a stable bucket in [0, 100) for the host, used to evaluate
+// RolloutConstraints.Percentage.
+func (h RolloutHost) rolloutBucket() int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(h.Service))
+	_, _ = hash.Write([]byte{'/'})
+	_, _ = hash.Write([]byte(h.Identity))
+	return int(hash.Sum32() % 100)
+}
+
+func hasRollout(cv ConstrainedValue) bool {
+	return !cv.Rollout.IsZero()
+}
+
+// applyRollout drops values whose rollout constraints don't include this host, and moves
+// values with matching rollout constraints ahead of the others so that findMatch prefers them.
+func (c *Collection) applyRollout(cvs []ConstrainedValue) []ConstrainedValue {
+	if !slices.ContainsFunc(cvs, hasRollout) {
+		return cvs
+	}
+	result := make([]ConstrainedValue, 0, len(cvs))
+	for _, cv := range cvs {
+		if hasRollout(cv) && cv.Rollout.matches(c.host, c.hostBucket) {
+			result = append(result, cv)
+		}
+	}
+	for _, cv := range cvs {
+		if !hasRollout(cv) {
+			result = append(result, cv)
+		}
+	}
+	return result
+}
+
+// recordRolloutState reports whether values of key with rollout constraints are used on this
+// host, so that the progress and effect of a rollout can be followed in metrics.
+func (c *Collection) recordRolloutState(key Key, oldValues []ConstrainedValue, newValues []ConstrainedValue) {
+	if !slices.ContainsFunc(oldValues, hasRollout) && !slices.ContainsFunc(newValues, hasRollout) {
+		return
+	}
+	active := slices.ContainsFunc(newValues, func(cv ConstrainedValue) bool {
+		return hasRollout(cv) && cv.Rollout.matches(c.host, c.hostBucket)
+	})
+	value := 0.0
+	if active {
+		value = 1.0
+	}
+	metrics.DynamicConfigRolloutActive.With(c.metricsHandler).Record(value, metrics.StringTag("key", key.String()))
+}
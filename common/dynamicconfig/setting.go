@@ -55,5 +55,6 @@ type (
 
 		// for internal use:
 		dispatchUpdate(*Collection, any, []ConstrainedValue)
+		effectiveValue(*Collection, Constraints) any
 	}
 )
@@ -49,6 +49,27 @@ const PrecedenceTaskType Precedence = 6
 
 const PrecedenceDestination Precedence = 7
 
+func (p Precedence) String() string {
+	switch p {
+	case PrecedenceGlobal:
+		return "Global"
+	case PrecedenceNamespace:
+		return "Namespace"
+	case PrecedenceNamespaceID:
+		return "NamespaceID"
+	case PrecedenceTaskQueue:
+		return "TaskQueue"
+	case PrecedenceShardID:
+		return "ShardID"
+	case PrecedenceTaskType:
+		return "TaskType"
+	case PrecedenceDestination:
+		return "Destination"
+	default:
+		return "Unknown"
+	}
+}
+
 type GlobalBoolSetting = GlobalTypedSetting[bool]
 
 func NewGlobalBoolSetting(key Key, def bool, description string) GlobalBoolSetting {
@@ -809,6 +830,10 @@ func (s GlobalTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []Cons
 	)
 }
 
+func (s GlobalTypedSetting[T]) effectiveValue(c *Collection, cs Constraints) any {
+	return s.Get(c)()
+}
+
 func GetTypedPropertyFn[T any](value T) TypedPropertyFn[T] {
 	return func() T {
 		return value
@@ -903,6 +928,10 @@ func (s NamespaceTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []C
 	)
 }
 
+func (s NamespaceTypedSetting[T]) effectiveValue(c *Collection, cs Constraints) any {
+	return s.Get(c)(cs.Namespace)
+}
+
 func GetTypedPropertyFnFilteredByNamespace[T any](value T) TypedPropertyFnWithNamespaceFilter[T] {
 	return func(namespace string) T {
 		return value
@@ -997,6 +1026,10 @@ func (s NamespaceIDTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs [
 	)
 }
 
+func (s NamespaceIDTypedSetting[T]) effectiveValue(c *Collection, cs Constraints) any {
+	return s.Get(c)(cs.NamespaceID)
+}
+
 func GetTypedPropertyFnFilteredByNamespaceID[T any](value T) TypedPropertyFnWithNamespaceIDFilter[T] {
 	return func(namespaceID string) T {
 		return value
@@ -1103,6 +1136,10 @@ func (s TaskQueueTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []C
 	)
 }
 
+func (s TaskQueueTypedSetting[T]) effectiveValue(c *Collection, cs Constraints) any {
+	return s.Get(c)(cs.Namespace, cs.TaskQueueName, cs.TaskQueueType)
+}
+
 func GetTypedPropertyFnFilteredByTaskQueue[T any](value T) TypedPropertyFnWithTaskQueueFilter[T] {
 	return func(namespace string, taskQueue string, taskQueueType enumspb.TaskQueueType) T {
 		return value
@@ -1197,6 +1234,10 @@ func (s ShardIDTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []Con
 	)
 }
 
+func (s ShardIDTypedSetting[T]) effectiveValue(c *Collection, cs Constraints) any {
+	return s.Get(c)(cs.ShardID)
+}
+
 func GetTypedPropertyFnFilteredByShardID[T any](value T) TypedPropertyFnWithShardIDFilter[T] {
 	return func(shardID int32) T {
 		return value
@@ -1291,6 +1332,10 @@ func (s TaskTypeTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs []Co
 	)
 }
 
+func (s TaskTypeTypedSetting[T]) effectiveValue(c *Collection, cs Constraints) any {
+	return s.Get(c)(cs.TaskType)
+}
+
 func GetTypedPropertyFnFilteredByTaskType[T any](value T) TypedPropertyFnWithTaskTypeFilter[T] {
 	return func(taskType enumsspb.TaskType) T {
 		return value
@@ -1395,6 +1440,10 @@ func (s DestinationTypedSetting[T]) dispatchUpdate(c *Collection, sub any, cvs [
 	)
 }
 
+func (s DestinationTypedSetting[T]) effectiveValue(c *Collection, cs Constraints) any {
+	return s.Get(c)(cs.Namespace, cs.Destination)
+}
+
 func GetTypedPropertyFnFilteredByDestination[T any](value T) TypedPropertyFnWithDestinationFilter[T] {
 	return func(namespace string, destination string) T {
 		return value
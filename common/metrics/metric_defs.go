@@ -48,6 +48,7 @@ const (
 	resourceExhaustedScopeTag   = "resource_exhausted_scope"
 	PartitionTagName            = "partition"
 	PriorityTagName             = "priority"
+	PriorityTierTagName         = "priority_tier"
 )
 
 // This package should hold all the metrics and tags for temporal
@@ -618,6 +619,9 @@ var (
 	TlsCertsExpired                          = NewGaugeDef("certificates_expired")
 	TlsCertsExpiring                         = NewGaugeDef("certificates_expiring")
 	ServiceAuthorizationLatency              = NewTimerDef("service_authorization_latency")
+	ServiceAuthorizationDecisions            = NewCounterDef("service_authorization_decisions")
+	ServiceAuthorizationAuditFailures        = NewCounterDef("service_authorization_audit_failures")
+	ServiceAuthorizationAuditDropped         = NewCounterDef("service_authorization_audit_dropped")
 	EventBlobSize                            = NewBytesHistogramDef("event_blob_size")
 	LockRequests                             = NewCounterDef("lock_requests")
 	LockLatency                              = NewTimerDef("lock_latency")
@@ -808,7 +812,15 @@ var (
 		"pending_tasks",
 		WithDescription("A histogram across history shards for the number of in-memory pending history tasks."),
 	)
-	TaskSchedulerThrottled                               = NewCounterDef("task_scheduler_throttled")
+	TaskSchedulerThrottled            = NewCounterDef("task_scheduler_throttled")
+	TaskSchedulerPriorityTierRequests = NewCounterDef(
+		"task_scheduler_priority_tier_requests",
+		WithDescription("The number of history tasks submitted to the host level task scheduler, by namespace priority tier. Estimated from a sample of the submitted tasks."),
+	)
+	TaskSchedulerPriorityTierWeight = NewGaugeDef(
+		"task_scheduler_priority_tier_weight",
+		WithDescription("The weight multiplier currently applied to task channels of namespaces in a priority tier."),
+	)
 	QueueScheduleLatency                                 = NewTimerDef("queue_latency_schedule") // latency for scheduling 100 tasks in one task channel
 	QueueReaderCountHistogram                            = NewDimensionlessHistogramDef("queue_reader_count")
 	QueueSliceCountHistogram                             = NewDimensionlessHistogramDef("queue_slice_count")
@@ -1197,4 +1209,14 @@ var (
 	MemoryStackGauge     = NewGaugeDef("memory_stack")
 	NumGCCounter         = NewBytesHistogramDef("memory_num_gc")
 	GcPauseMsTimer       = NewTimerDef("memory_gc_pause_ms")
+
+	// Dynamic config
+	DynamicConfigValueChanges = NewCounterDef(
+		"dynamic_config_value_changes",
+		WithDescription("The number of changed dynamic config values, by key and change type (added, removed, changed)."),
+	)
+	DynamicConfigRolloutActive = NewGaugeDef(
+		"dynamic_config_rollout_active",
+		WithDescription("1 if values of a dynamic config key with rollout constraints are used on this host, 0 otherwise."),
+	)
 )
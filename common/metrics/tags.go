@@ -271,6 +271,13 @@ func TaskPriorityTag(value string) Tag {
 	return &tagImpl{key: TaskPriorityTagName, value: value}
 }
 
+func PriorityTierTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: PriorityTierTagName, value: value}
+}
+
 func QueueReaderIDTag(readerID int64) Tag {
 	return &tagImpl{key: QueueReaderIDTagName, value: strconv.Itoa(int(readerID))}
 }
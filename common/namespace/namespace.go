@@ -339,6 +339,11 @@ func (ns *Namespace) CustomSearchAttributesMapper() CustomSearchAttributesMapper
 	return ns.customSearchAttributesMapper
 }
 
+// Quotas returns the API quotas of the namespace in the current cluster, or nil if there are none.
+func (ns *Namespace) Quotas() *persistencespb.NamespaceQuotas {
+	return ns.config.GetQuotas()
+}
+
 // Error returns the reason associated with this bad binary.
 func (e BadBinaryError) Error() string {
 	return e.info.Reason
@@ -307,6 +307,8 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			VisibilityArchivalState:      task.Config.GetVisibilityArchivalState(),
 			VisibilityArchivalUri:        task.Config.GetVisibilityArchivalUri(),
 			CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
+			// quotas are local to each cluster and not part of the replication task
+			Quotas: resp.Namespace.Config.GetQuotas(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
@@ -27,6 +27,7 @@ package calculator
 var (
 	_ Calculator          = (*ClusterAwareQuotaCalculator)(nil)
 	_ NamespaceCalculator = (*ClusterAwareNamespaceQuotaCalculator)(nil)
+	_ NamespaceCalculator = (*NamespaceOverrideQuotaCalculator)(nil)
 )
 
 type (
@@ -41,6 +42,15 @@ type (
 	// ClusterAwareNamespaceQuotaCalculator is similar to ClusterAwareQuotaCalculator, but it uses quotas that
 	// are specific to a namespace.
 	ClusterAwareNamespaceQuotaCalculator quotaCalculator[func(namespace string) int]
+	// NamespaceOverrideQuotaCalculator uses the per cluster quotas stored in namespace metadata, which take
+	// precedence over the quotas of the wrapped Calculator. Namespaces without a stored quota use the Calculator.
+	NamespaceOverrideQuotaCalculator struct {
+		MemberCounter MemberCounter
+		// NamespaceQuota is a function that returns the per cluster limit stored for a namespace, or zero if
+		// there is none.
+		NamespaceQuota func(namespace string) int
+		Calculator     NamespaceCalculator
+	}
 	// quotaCalculator is a generic type that we use because the quota functions could be namespace specific or not.
 	quotaCalculator[T any] struct {
 		MemberCounter MemberCounter
@@ -72,3 +82,10 @@ func (l ClusterAwareQuotaCalculator) GetQuota() float64 {
 func (l ClusterAwareNamespaceQuotaCalculator) GetQuota(namespace string) float64 {
 	return getQuota(l.MemberCounter, l.PerInstanceQuota(namespace), l.GlobalQuota(namespace))
 }
+
+func (l NamespaceOverrideQuotaCalculator) GetQuota(namespace string) float64 {
+	if quota := l.NamespaceQuota(namespace); quota > 0 {
+		return getQuota(l.MemberCounter, quota, quota)
+	}
+	return l.Calculator.GetQuota(namespace)
+}
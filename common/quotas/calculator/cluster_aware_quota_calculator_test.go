@@ -119,3 +119,48 @@ func TestClusterAwareNamespaceSpecificQuotaCalculator_GetQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestNamespaceOverrideQuotaCalculator_GetQuota(t *testing.T) {
+	t.Parallel()
+
+	calculator := ClusterAwareNamespaceQuotaCalculator{
+		MemberCounter:    quotastest.NewFakeMemberCounter(4),
+		PerInstanceQuota: perNamespaceQuota{t: t, quota: 10}.getQuota,
+		GlobalQuota:      perNamespaceQuota{t: t, quota: 20}.getQuota,
+	}
+	for _, tc := range []struct {
+		name          string
+		memberCounter MemberCounter
+		stored        int
+		expected      float64
+	}{
+		{
+			name:          "stored quota",
+			memberCounter: quotastest.NewFakeMemberCounter(4),
+			stored:        100,
+			expected:      25.0,
+		},
+		{
+			name:          "stored quota with no hosts",
+			memberCounter: quotastest.NewFakeMemberCounter(0),
+			stored:        100,
+			expected:      100.0,
+		},
+		{
+			name:          "no stored quota",
+			memberCounter: quotastest.NewFakeMemberCounter(4),
+			stored:        0,
+			expected:      5.0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, NamespaceOverrideQuotaCalculator{
+				MemberCounter:  tc.memberCounter,
+				NamespaceQuota: perNamespaceQuota{t: t, quota: tc.stored}.getQuota,
+				Calculator:     calculator,
+			}.GetQuota("test-namespace"))
+		})
+	}
+}
@@ -73,17 +73,26 @@ func NewConcurrentRequestLimitInterceptor(
 	logger log.Logger,
 	perInstanceQuota func(ns string) int,
 	globalQuota func(ns string) int,
+	namespaceQuota func(ns string) int,
 	tokens map[string]int,
 ) *ConcurrentRequestLimitInterceptor {
+	var quotaCalculator calculator.NamespaceCalculator = calculator.ClusterAwareNamespaceQuotaCalculator{
+		MemberCounter:    memberCounter,
+		PerInstanceQuota: perInstanceQuota,
+		GlobalQuota:      globalQuota,
+	}
+	if namespaceQuota != nil {
+		quotaCalculator = calculator.NamespaceOverrideQuotaCalculator{
+			MemberCounter:  memberCounter,
+			NamespaceQuota: namespaceQuota,
+			Calculator:     quotaCalculator,
+		}
+	}
 	return &ConcurrentRequestLimitInterceptor{
 		namespaceRegistry: namespaceRegistry,
 		logger:            logger,
 		quotaCalculator: calculator.NewLoggedNamespaceCalculator(
-			calculator.ClusterAwareNamespaceQuotaCalculator{
-				MemberCounter:    memberCounter,
-				PerInstanceQuota: perInstanceQuota,
-				GlobalQuota:      globalQuota,
-			},
+			quotaCalculator,
 			log.With(logger, tag.ComponentLongPollHandler, tag.ScopeNamespace),
 		),
 		tokens:            tokens,
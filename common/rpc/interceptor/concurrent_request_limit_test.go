@@ -230,6 +230,7 @@ func (tc *nsCountLimitTestCase) createInterceptor(ctrl *gomock.Controller) *Conc
 		log.NewNoopLogger(),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(tc.perInstanceLimit),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(tc.globalLimit),
+		nil,
 		tc.tokens,
 	)
 
@@ -80,6 +80,8 @@ var (
 		"AddTasks":                  {},
 		"ListQueues":                {},
 		"ListTasks":                 {},
+		"DescribeHistoryQueue":      {},
+		"ExecuteHistoryQueueAction": {},
 		"CompleteNexusOperation":    {}, // NamespaceId is in the completion token for this request.
 		"DeepHealthCheck":           {},
 	}
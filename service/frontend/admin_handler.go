@@ -26,6 +26,7 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -47,6 +48,7 @@ import (
 	sdkclient "go.temporal.io/sdk/client"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/api/adminservice/v1"
@@ -62,11 +64,14 @@ import (
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -75,6 +80,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
@@ -93,6 +99,10 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+
+	restoreFromArchivePageSize  = 100
+	restoreFromArchiveBlobCount = 16
+	restoreFromArchiveBlobSize  = 256 * 1024 // 256K
 )
 
 type (
@@ -127,6 +137,11 @@ type (
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
 		historyHealthChecker       HealthChecker
+		archiverProvider           provider.ArchiverProvider
+		archivalMetadata           archiver.ArchivalMetadata
+		dynamicConfig              *dynamicconfig.Collection
+		timeSource                 clock.TimeSource
+		namespaceHandler           *namespaceHandler
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -159,6 +174,10 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		ArchiverProvider                    provider.ArchiverProvider
+		ArchivalMetadata                    archiver.ArchivalMetadata
+		namespaceHandler                    *namespaceHandler
+		DynamicConfig                       *dynamicconfig.Collection
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -227,6 +246,11 @@ func NewAdminHandler(
 		clusterMetadata:            args.ClusterMetadata,
 		healthServer:               args.HealthServer,
 		historyHealthChecker:       historyHealthChecker,
+		archiverProvider:           args.ArchiverProvider,
+		archivalMetadata:           args.ArchivalMetadata,
+		dynamicConfig:              args.DynamicConfig,
+		timeSource:                 args.TimeSource,
+		namespaceHandler:           args.namespaceHandler,
 		taskCategoryRegistry:       args.CategoryRegistry,
 	}
 }
@@ -264,6 +288,145 @@ func (adh *AdminHandler) DeepHealthCheck(
 	return &adminservice.DeepHealthCheckResponse{State: healthStatus}, nil
 }
 
+// DescribeDynamicConfig returns the dynamic config values, effective values and recent changes
+// as seen by this frontend host. Other hosts may briefly see different values while they reload.
+func (adh *AdminHandler) DescribeDynamicConfig(
+	_ context.Context,
+	request *adminservice.DescribeDynamicConfigRequest,
+) (_ *adminservice.DescribeDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	constraints := dynamicconfig.Constraints{
+		Namespace:     request.GetNamespace(),
+		NamespaceID:   request.GetNamespaceId(),
+		TaskQueueName: request.GetTaskQueue(),
+		TaskQueueType: request.GetTaskQueueType(),
+		ShardID:       request.GetShardId(),
+		TaskType:      request.GetTaskType(),
+		Destination:   request.GetDestination(),
+	}
+
+	response := &adminservice.DescribeDynamicConfigResponse{}
+	keys := []dynamicconfig.Key{dynamicconfig.Key(request.GetKey())}
+	if request.GetKey() == "" {
+		keys = adh.dynamicConfig.ConfiguredKeys()
+		response.History = convertDynamicConfigChangeRecordsToProto(adh.dynamicConfig.ChangeHistory())
+	}
+	for _, key := range keys {
+		desc := adh.dynamicConfig.DescribeKey(key, constraints)
+		keyInfo := &adminservice.DescribeDynamicConfigResponse_KeyInfo{
+			Key:        desc.Key.String(),
+			Registered: desc.Registered,
+			History:    convertDynamicConfigChangeRecordsToProto(desc.History),
+		}
+		if desc.Registered {
+			keyInfo.Precedence = desc.Precedence.String()
+			keyInfo.EffectiveValue = encodeDynamicConfigValue(desc.EffectiveValue)
+		}
+		for _, cv := range desc.Values {
+			keyInfo.Values = append(keyInfo.Values, &adminservice.DescribeDynamicConfigResponse_ConstrainedValue{
+				Constraints: convertDynamicConfigConstraintsToProto(cv.Constraints, cv.Rollout),
+				Value:       encodeDynamicConfigValue(cv.Value),
+			})
+		}
+		response.Keys = append(response.Keys, keyInfo)
+	}
+	return response, nil
+}
+
+func (adh *AdminHandler) SetNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.SetNamespaceQuotasRequest,
+) (_ *adminservice.SetNamespaceQuotasResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	quotas := request.GetQuotas()
+	if quotas.GetFrontendRps() < 0 ||
+		quotas.GetFrontendBurstRatio() < 0 ||
+		quotas.GetVisibilityRps() < 0 ||
+		quotas.GetConcurrentLongPollLimit() < 0 {
+		return nil, serviceerror.NewInvalidArgument("Namespace quotas must not be negative.")
+	}
+	if proto.Equal(quotas, &persistencespb.NamespaceQuotas{}) {
+		quotas = nil
+	}
+
+	if err := adh.namespaceHandler.UpdateNamespaceQuotas(ctx, request.GetNamespace(), quotas); err != nil {
+		return nil, err
+	}
+	return &adminservice.SetNamespaceQuotasResponse{}, nil
+}
+
+func (adh *AdminHandler) GetNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.GetNamespaceQuotasRequest,
+) (_ *adminservice.GetNamespaceQuotasResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: request.GetNamespace(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetNamespaceQuotasResponse{
+		Quotas: resp.Namespace.Config.GetQuotas(),
+	}, nil
+}
+
+func (adh *AdminHandler) ListNamespaceQuotas(
+	ctx context.Context,
+	request *adminservice.ListNamespaceQuotasRequest,
+) (_ *adminservice.ListNamespaceQuotasResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	pageSize := 100
+	if request.GetPageSize() > 0 {
+		pageSize = int(request.GetPageSize())
+	}
+	resp, err := adh.persistenceMetadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &adminservice.ListNamespaceQuotasResponse{
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, ns := range resp.Namespaces {
+		if ns.Namespace.Config.GetQuotas() == nil {
+			continue
+		}
+		response.Namespaces = append(response.Namespaces, &adminservice.ListNamespaceQuotasResponse_NamespaceQuotas{
+			Namespace:   ns.Namespace.Info.GetName(),
+			NamespaceId: ns.Namespace.Info.GetId(),
+			Quotas:      ns.Namespace.Config.GetQuotas(),
+		})
+	}
+	return response, nil
+}
+
 // AddSearchAttributes add search attribute to the cluster.
 func (adh *AdminHandler) AddSearchAttributes(
 	ctx context.Context,
@@ -724,6 +887,142 @@ func (adh *AdminHandler) ImportWorkflowExecution(
 	}, nil
 }
 
+// RestoreWorkflowExecutionFromArchive reads the archived history of a closed workflow execution and imports it back
+// into primary persistence. The history is imported in chunks through the history service, which rebuilds mutable
+// state and regenerates tasks on commit. The restored execution is subject to namespace retention again, counted from
+// the restore time, so that executions closed longer ago than the retention period are not deleted right away.
+// Like GetWorkflowExecutionHistory, it only reads from the archive if archival reads are enabled for the cluster.
+func (adh *AdminHandler) RestoreWorkflowExecutionFromArchive(
+	ctx context.Context,
+	request *adminservice.RestoreWorkflowExecutionFromArchiveRequest,
+) (_ *adminservice.RestoreWorkflowExecutionFromArchiveResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.Execution.GetRunId() == "" {
+		return nil, errRunIDNotSet
+	}
+
+	if !adh.archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() {
+		return nil, errClusterIsNotConfiguredForHistoryArchival
+	}
+	if !adh.archivalMetadata.GetHistoryConfig().ReadEnabled() {
+		return nil, errClusterIsNotConfiguredForReadingArchivalHistory
+	}
+
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	archivalState := namespaceEntry.HistoryArchivalState()
+	if archivalState.State != enumspb.ARCHIVAL_STATE_ENABLED || archivalState.URI == "" {
+		return nil, errHistoryArchivalNotEnabledForNamespace
+	}
+	URI, err := archiver.NewURI(archivalState.URI)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := adh.archiverProvider.GetHistoryArchiver(URI.Scheme(), string(primitives.FrontendService))
+	if err != nil {
+		return nil, err
+	}
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: namespaceEntry.ID().String(),
+		WorkflowID:  request.Execution.GetWorkflowId(),
+		RunID:       request.Execution.GetRunId(),
+		PageSize:    restoreFromArchivePageSize,
+	}
+
+	// The history service expects the final version history with every import call,
+	// so the archived history is read twice: once to build the version history and once to import it.
+	versionHistory := versionhistory.NewVersionHistory(nil, nil)
+	if err := forEachArchivedHistoryBatch(ctx, historyArchiver, URI, getRequest, func(batch *historypb.History) error {
+		lastEvent := batch.Events[len(batch.Events)-1]
+		return versionhistory.AddOrUpdateVersionHistoryItem(
+			versionHistory,
+			versionhistory.NewVersionHistoryItem(lastEvent.GetEventId(), lastEvent.GetVersion()),
+		)
+	}); err != nil {
+		return nil, err
+	}
+	if versionhistory.IsEmptyVersionHistory(versionHistory) {
+		return nil, errHistoryNotFound
+	}
+
+	var blobs []*commonpb.DataBlob
+	var token []byte
+	blobSize := 0
+	eventsVersion := common.EmptyVersion
+	eventCount := int64(0)
+	importFn := func() error {
+		resp, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+			NamespaceId:        namespaceEntry.ID().String(),
+			Execution:          request.Execution,
+			HistoryBatches:     blobs,
+			VersionHistory:     versionHistory,
+			Token:              token,
+			RestoreFromArchive: true,
+		})
+		if err != nil {
+			return err
+		}
+		token = resp.Token
+		blobs = nil
+		blobSize = 0
+		eventsVersion = common.EmptyVersion
+		return nil
+	}
+
+	getRequest.NextPageToken = nil
+	if err := forEachArchivedHistoryBatch(ctx, historyArchiver, URI, getRequest, func(batch *historypb.History) error {
+		if eventsVersion != common.EmptyVersion && eventsVersion != batch.Events[0].GetVersion() {
+			if err := importFn(); err != nil {
+				return err
+			}
+		}
+		blob, err := adh.eventSerializer.SerializeEvents(batch.Events, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return err
+		}
+		eventsVersion = batch.Events[0].GetVersion()
+		eventCount += int64(len(batch.Events))
+		blobSize += len(blob.Data)
+		blobs = append(blobs, blob)
+
+		if blobSize >= restoreFromArchiveBlobSize || len(blobs) >= restoreFromArchiveBlobCount {
+			return importFn()
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(blobs) != 0 {
+		if err := importFn(); err != nil {
+			return nil, err
+		}
+	}
+
+	// call with empty event blob to commit the import
+	if err := importFn(); err != nil {
+		return nil, err
+	}
+	if len(token) != 0 {
+		return nil, serviceerror.NewInternal("Failed to commit import transaction")
+	}
+
+	return &adminservice.RestoreWorkflowExecutionFromArchiveResponse{
+		EventCount: eventCount,
+	}, nil
+}
+
 // DescribeMutableState returns information about the specified workflow execution.
 func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *adminservice.DescribeMutableStateRequest) (_ *adminservice.DescribeMutableStateResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -839,6 +1138,59 @@ func (adh *AdminHandler) ListHistoryTasks(
 	return resp.Response, nil
 }
 
+// DescribeHistoryQueue returns the in-memory state of a history task queue of a shard
+func (adh *AdminHandler) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+) (_ *adminservice.DescribeHistoryQueueResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp, err := adh.historyClient.DescribeHistoryQueue(ctx, &historyservice.DescribeHistoryQueueRequest{
+		ShardId:  request.GetShardId(),
+		Category: request.GetCategory(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response, nil
+}
+
+// ExecuteHistoryQueueAction manually runs an action on a history task queue of a shard
+func (adh *AdminHandler) ExecuteHistoryQueueAction(
+	ctx context.Context,
+	request *adminservice.ExecuteHistoryQueueActionRequest,
+) (_ *adminservice.ExecuteHistoryQueueActionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	historyRequest := &historyservice.ExecuteHistoryQueueActionRequest{
+		ShardId:  request.GetShardId(),
+		Category: request.GetCategory(),
+	}
+	switch action := request.GetAction().(type) {
+	case *adminservice.ExecuteHistoryQueueActionRequest_Split:
+		historyRequest.Action = &historyservice.ExecuteHistoryQueueActionRequest_Split{Split: action.Split}
+	case *adminservice.ExecuteHistoryQueueActionRequest_Shrink:
+		historyRequest.Action = &historyservice.ExecuteHistoryQueueActionRequest_Shrink{Shrink: action.Shrink}
+	case *adminservice.ExecuteHistoryQueueActionRequest_Pause:
+		historyRequest.Action = &historyservice.ExecuteHistoryQueueActionRequest_Pause{Pause: action.Pause}
+	default:
+		return nil, errQueueActionNotSet
+	}
+
+	if _, err := adh.historyClient.ExecuteHistoryQueueAction(ctx, historyRequest); err != nil {
+		return nil, err
+	}
+	return &adminservice.ExecuteHistoryQueueActionResponse{}, nil
+}
+
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *adminservice.DescribeHistoryHostRequest) (_ *adminservice.DescribeHistoryHostResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -2068,6 +2420,93 @@ func validateHistoryDLQKey(
 	return nil
 }
 
+// forEachArchivedHistoryBatch pages through the archived history of a workflow execution
+// and invokes fn for every non-empty history batch in event order.
+func forEachArchivedHistoryBatch(
+	ctx context.Context,
+	historyArchiver archiver.HistoryArchiver,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+	fn func(batch *historypb.History) error,
+) error {
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			return err
+		}
+		for _, batch := range resp.HistoryBatches {
+			if len(batch.Events) == 0 {
+				continue
+			}
+			if err := fn(batch); err != nil {
+				return err
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func convertDynamicConfigChangeRecordsToProto(
+	records []dynamicconfig.ChangeRecord,
+) []*adminservice.DescribeDynamicConfigResponse_ChangeRecord {
+	var result []*adminservice.DescribeDynamicConfigResponse_ChangeRecord
+	for _, record := range records {
+		recordProto := &adminservice.DescribeDynamicConfigResponse_ChangeRecord{
+			Time: timestamppb.New(record.Time),
+		}
+		for _, change := range record.Changes {
+			changeProto := &adminservice.DescribeDynamicConfigResponse_Change{
+				Key:         change.Key.String(),
+				Constraints: convertDynamicConfigConstraintsToProto(change.Constraints, change.Rollout),
+				ChangeType:  change.Type.String(),
+			}
+			if change.Type != dynamicconfig.ValueAdded {
+				changeProto.OldValue = encodeDynamicConfigValue(change.OldValue)
+			}
+			if change.Type != dynamicconfig.ValueRemoved {
+				changeProto.NewValue = encodeDynamicConfigValue(change.NewValue)
+			}
+			recordProto.Changes = append(recordProto.Changes, changeProto)
+		}
+		result = append(result, recordProto)
+	}
+	return result
+}
+
+func convertDynamicConfigConstraintsToProto(
+	constraints dynamicconfig.Constraints,
+	rollout dynamicconfig.RolloutConstraints,
+) *adminservice.DescribeDynamicConfigResponse_Constraints {
+	return &adminservice.DescribeDynamicConfigResponse_Constraints{
+		Namespace:         constraints.Namespace,
+		NamespaceId:       constraints.NamespaceID,
+		TaskQueue:         constraints.TaskQueueName,
+		TaskQueueType:     constraints.TaskQueueType,
+		ShardId:           constraints.ShardID,
+		TaskType:          constraints.TaskType,
+		Destination:       constraints.Destination,
+		RolloutService:    rollout.Service,
+		RolloutHost:       rollout.Host,
+		RolloutPercentage: int32(rollout.Percentage),
+	}
+}
+
+// encodeDynamicConfigValue encodes a dynamic config value as JSON. Durations are encoded
+// as strings since that's how they are usually written in dynamic config files.
+func encodeDynamicConfigValue(value any) string {
+	if d, ok := value.(time.Duration); ok {
+		value = d.String()
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
 func convertClusterReplicationConfigToProto(
 	input []string,
 ) []*replicationpb.ClusterReplicationConfig {
@@ -39,6 +39,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -46,23 +47,29 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
@@ -70,6 +77,7 @@ import (
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protoassert"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/dlq"
 )
@@ -91,6 +99,7 @@ type (
 		mockAdminClient            *adminservicemock.MockAdminServiceClient
 		mockMetadata               *cluster.MockMetadata
 		mockProducer               *persistence.MockNamespaceReplicationQueue
+		dynamicConfigClient        *dynamicconfig.MemoryClient
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -132,6 +141,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockMetadata = s.mockResource.ClusterMetadata
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
+	s.dynamicConfigClient = dynamicconfig.NewMemoryClient()
 
 	persistenceConfig := &config.Persistence{
 		NumHistoryShards: 1,
@@ -166,6 +176,20 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		s.mockResource.GetArchiverProvider(),
+		s.mockResource.GetArchivalMetadata(),
+		newNamespaceHandler(
+			cfg.MaxBadBinaries,
+			s.mockResource.GetLogger(),
+			s.mockResource.GetMetadataManager(),
+			s.mockMetadata,
+			namespace.NewNamespaceReplicator(s.mockProducer, s.mockResource.GetLogger()),
+			s.mockResource.GetArchivalMetadata(),
+			s.mockResource.GetArchiverProvider(),
+			cfg.EnableSchedules,
+			clock.NewRealTimeSource(),
+		),
+		dynamicconfig.NewCollection(s.dynamicConfigClient, s.mockResource.GetLogger()),
 		tasks.NewDefaultTaskCategoryRegistry(),
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
@@ -1652,3 +1676,303 @@ func (s *adminHandlerSuite) TestListQueues_Err() {
 	_, err := s.handler.ListQueues(context.Background(), &adminservice.ListQueuesRequest{})
 	s.ErrorIs(err, assert.AnError)
 }
+
+func (s *adminHandlerSuite) TestRestoreWorkflowExecutionFromArchive() {
+	s.mockResource.ArchivalMetadata.SetHistoryEnabledByDefault()
+	archivalURI := "test:///restore/archive"
+	namespaceEntry := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Name: s.namespace.String(),
+			Id:   s.namespaceID.String(),
+		},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   archivalURI,
+		},
+		false,
+		nil,
+		int64(100),
+	)
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	firstPage := &archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{
+			{Events: []*historypb.HistoryEvent{{EventId: 1, Version: 1}, {EventId: 2, Version: 1}}},
+			{Events: []*historypb.HistoryEvent{{EventId: 3, Version: 1}}},
+		},
+		NextPageToken: []byte("next page"),
+	}
+	secondPage := &archiver.GetHistoryResponse{
+		HistoryBatches: []*historypb.History{
+			{Events: []*historypb.HistoryEvent{{EventId: 4, Version: 2}, {EventId: 5, Version: 2}}},
+		},
+	}
+	expectedVersionHistory := versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(3, 1),
+		versionhistory.NewVersionHistoryItem(5, 2),
+	})
+
+	mockHistoryArchiver := archiver.NewMockHistoryArchiver(s.controller)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+	s.mockResource.ArchiverProvider.EXPECT().GetHistoryArchiver("test", string(primitives.FrontendService)).Return(mockHistoryArchiver, nil)
+	for i := 0; i < 2; i++ {
+		mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+				s.Equal(execution.GetRunId(), request.RunID)
+				s.Empty(request.NextPageToken)
+				return firstPage, nil
+			},
+		)
+		mockHistoryArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+				s.Equal(firstPage.NextPageToken, request.NextPageToken)
+				return secondPage, nil
+			},
+		)
+	}
+
+	var importRequests []*historyservice.ImportWorkflowExecutionRequest
+	s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ImportWorkflowExecutionRequest, _ ...interface{}) (*historyservice.ImportWorkflowExecutionResponse, error) {
+			importRequests = append(importRequests, request)
+			if len(request.HistoryBatches) == 0 {
+				return &historyservice.ImportWorkflowExecutionResponse{}, nil
+			}
+			return &historyservice.ImportWorkflowExecutionResponse{Token: []byte("import token")}, nil
+		},
+	).Times(3)
+
+	resp, err := s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Equal(int64(5), resp.GetEventCount())
+
+	s.Len(importRequests, 3)
+	// events with a different version are imported separately
+	s.Len(importRequests[0].HistoryBatches, 2)
+	s.Nil(importRequests[0].Token)
+	s.Len(importRequests[1].HistoryBatches, 1)
+	s.Equal([]byte("import token"), importRequests[1].Token)
+	// the final call commits the import
+	s.Empty(importRequests[2].HistoryBatches)
+	s.Equal([]byte("import token"), importRequests[2].Token)
+	for _, request := range importRequests {
+		s.Equal(s.namespaceID.String(), request.NamespaceId)
+		s.Equal(execution, request.Execution)
+		s.True(proto.Equal(expectedVersionHistory, request.VersionHistory))
+		s.True(request.RestoreFromArchive)
+	}
+}
+
+func (s *adminHandlerSuite) TestRestoreWorkflowExecutionFromArchive_ArchivalNotConfigured() {
+	s.mockResource.ArchivalMetadata.SetHistoryEnabledByDefault()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+
+	_, err := s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "some random workflow ID",
+			RunId:      uuid.New(),
+		},
+	})
+	s.Equal(errHistoryArchivalNotEnabledForNamespace, err)
+}
+
+func (s *adminHandlerSuite) TestRestoreWorkflowExecutionFromArchive_ArchivalDisabled() {
+	s.mockResource.ArchivalMetadata.SetHistoryEnabledByDefault()
+	namespaceEntry := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{
+			Name: s.namespace.String(),
+			Id:   s.namespaceID.String(),
+		},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_DISABLED,
+			HistoryArchivalUri:   "test:///restore/archive",
+		},
+		false,
+		nil,
+		int64(100),
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+
+	_, err := s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "some random workflow ID",
+			RunId:      uuid.New(),
+		},
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
+
+func (s *adminHandlerSuite) TestRestoreWorkflowExecutionFromArchive_ReadDisabled() {
+	s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(
+		archiver.NewArchivalConfig("enabled", dynamicconfig.GetStringPropertyFn("enabled"), dynamicconfig.GetBoolPropertyFn(false), "enabled", "test:///restore/archive"),
+	).AnyTimes()
+
+	_, err := s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "some random workflow ID",
+			RunId:      uuid.New(),
+		},
+	})
+	s.Equal(errClusterIsNotConfiguredForReadingArchivalHistory, err)
+}
+
+func (s *adminHandlerSuite) TestRestoreWorkflowExecutionFromArchive_RunIDNotSet() {
+	_, err := s.handler.RestoreWorkflowExecutionFromArchive(context.Background(), &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "some random workflow ID",
+		},
+	})
+	s.Equal(errRunIDNotSet, err)
+}
+
+func (s *adminHandlerSuite) TestDescribeDynamicConfig() {
+	s.dynamicConfigClient.OverrideValueByKey(dynamicconfig.BlobSizeLimitError.Key(), []dynamicconfig.ConstrainedValue{
+		{Value: 100},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns1"}, Value: 200},
+	})
+
+	resp, err := s.handler.DescribeDynamicConfig(context.Background(), &adminservice.DescribeDynamicConfigRequest{
+		Key:       dynamicconfig.BlobSizeLimitError.Key().String(),
+		Namespace: "ns1",
+	})
+	s.NoError(err)
+	s.Len(resp.Keys, 1)
+	keyInfo := resp.Keys[0]
+	s.Equal(dynamicconfig.BlobSizeLimitError.Key().String(), keyInfo.Key)
+	s.True(keyInfo.Registered)
+	s.Equal(dynamicconfig.BlobSizeLimitError.Precedence().String(), keyInfo.Precedence)
+	s.Equal("200", keyInfo.EffectiveValue)
+	s.Len(keyInfo.Values, 2)
+	s.Equal("100", keyInfo.Values[0].Value)
+	s.Equal("ns1", keyInfo.Values[1].Constraints.Namespace)
+	s.Equal("200", keyInfo.Values[1].Value)
+	s.Empty(resp.History)
+}
+
+func (s *adminHandlerSuite) TestDescribeDynamicConfig_UnregisteredKey() {
+	s.dynamicConfigClient.OverrideValueByKey("some.unknown.key", "abc")
+
+	resp, err := s.handler.DescribeDynamicConfig(context.Background(), &adminservice.DescribeDynamicConfigRequest{
+		Key: "some.unknown.key",
+	})
+	s.NoError(err)
+	s.Len(resp.Keys, 1)
+	s.False(resp.Keys[0].Registered)
+	s.Empty(resp.Keys[0].EffectiveValue)
+	s.Equal(`"abc"`, resp.Keys[0].Values[0].Value)
+}
+
+func (s *adminHandlerSuite) TestSetNamespaceQuotas() {
+	ctx := context.Background()
+	quotas := &persistencespb.NamespaceQuotas{
+		FrontendRps:             100,
+		FrontendBurstRatio:      2,
+		VisibilityRps:           10,
+		ConcurrentLongPollLimit: 50,
+	}
+	detail := &persistencespb.NamespaceDetail{
+		Info:   &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		Config: &persistencespb.NamespaceConfig{HistoryArchivalUri: "file:///tmp/history"},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: "active",
+			Clusters:          []string{"active", "standby"},
+		},
+		ConfigVersion:   3,
+		FailoverVersion: 5,
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).
+		Return(&persistence.GetNamespaceResponse{Namespace: detail, IsGlobalNamespace: true}, nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(7), request.NotificationVersion)
+			s.Equal(int64(4), request.Namespace.ConfigVersion)
+			s.Equal(int64(5), request.Namespace.FailoverVersion)
+			s.True(request.IsGlobalNamespace)
+			s.Equal("file:///tmp/history", request.Namespace.Config.HistoryArchivalUri)
+			protoassert.ProtoEqual(s.T(), quotas, request.Namespace.Config.Quotas)
+			return nil
+		})
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *replicationspb.ReplicationTask) error {
+			attributes := task.GetNamespaceTaskAttributes()
+			s.Equal(enumsspb.NAMESPACE_OPERATION_UPDATE, attributes.GetNamespaceOperation())
+			s.Equal(int64(4), attributes.GetConfigVersion())
+			return nil
+		})
+
+	_, err := s.handler.SetNamespaceQuotas(ctx, &adminservice.SetNamespaceQuotasRequest{
+		Namespace: s.namespace.String(),
+		Quotas:    quotas,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestSetNamespaceQuotas_InvalidArgument() {
+	ctx := context.Background()
+
+	_, err := s.handler.SetNamespaceQuotas(ctx, &adminservice.SetNamespaceQuotasRequest{})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	_, err = s.handler.SetNamespaceQuotas(ctx, &adminservice.SetNamespaceQuotasRequest{
+		Namespace: s.namespace.String(),
+		Quotas:    &persistencespb.NamespaceQuotas{FrontendRps: -1},
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+}
+
+func (s *adminHandlerSuite) TestGetNamespaceQuotas() {
+	ctx := context.Background()
+	quotas := &persistencespb.NamespaceQuotas{FrontendRps: 100}
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).
+		Return(&persistence.GetNamespaceResponse{Namespace: &persistencespb.NamespaceDetail{
+			Info:   &persistencespb.NamespaceInfo{Name: s.namespace.String()},
+			Config: &persistencespb.NamespaceConfig{Quotas: quotas},
+		}}, nil)
+
+	resp, err := s.handler.GetNamespaceQuotas(ctx, &adminservice.GetNamespaceQuotasRequest{Namespace: s.namespace.String()})
+	s.NoError(err)
+	protoassert.ProtoEqual(s.T(), quotas, resp.Quotas)
+}
+
+func (s *adminHandlerSuite) TestListNamespaceQuotas() {
+	ctx := context.Background()
+	quotas := &persistencespb.NamespaceQuotas{VisibilityRps: 5}
+	s.mockResource.MetadataMgr.EXPECT().ListNamespaces(gomock.Any(), &persistence.ListNamespacesRequest{
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces: []*persistence.GetNamespaceResponse{
+			{Namespace: &persistencespb.NamespaceDetail{
+				Info:   &persistencespb.NamespaceInfo{Id: "id-1", Name: "with-quotas"},
+				Config: &persistencespb.NamespaceConfig{Quotas: quotas},
+			}},
+			{Namespace: &persistencespb.NamespaceDetail{
+				Info:   &persistencespb.NamespaceInfo{Id: "id-2", Name: "without-quotas"},
+				Config: &persistencespb.NamespaceConfig{},
+			}},
+		},
+		NextPageToken: []byte("next"),
+	}, nil)
+
+	resp, err := s.handler.ListNamespaceQuotas(ctx, &adminservice.ListNamespaceQuotasRequest{
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("next"), resp.NextPageToken)
+	s.Len(resp.Namespaces, 1)
+	s.Equal("with-quotas", resp.Namespaces[0].Namespace)
+	s.Equal("id-1", resp.Namespaces[0].NamespaceId)
+	protoassert.ProtoEqual(s.T(), quotas, resp.Namespaces[0].Quotas)
+}
@@ -37,6 +37,7 @@ var (
 	errActivityIDNotSet                                   = serviceerror.NewInvalidArgument("ActivityId is not set on request.")
 	errSignalNameNotSet                                   = serviceerror.NewInvalidArgument("SignalName is not set on request.")
 	errInvalidRunID                                       = serviceerror.NewInvalidArgument("Invalid RunId.")
+	errRunIDNotSet                                        = serviceerror.NewInvalidArgument("RunId is not set on request.")
 	errInvalidNextPageToken                               = serviceerror.NewInvalidArgument("Invalid NextPageToken.")                                 // DEPRECATED
 	errNextPageTokenRunIDMismatch                         = serviceerror.NewInvalidArgument("RunId in the request does not match the NextPageToken.") // DEPRECATED
 	errQueryNotSet                                        = serviceerror.NewInvalidArgument("WorkflowQuery is not set on request.")
@@ -51,6 +52,7 @@ var (
 	errClusterNameNotSet                                  = serviceerror.NewInvalidArgument("Cluster name is not set.")
 	errEmptyReplicationInfo                               = serviceerror.NewInvalidArgument("Replication task info is not set.")
 	errTaskRangeNotSet                                    = serviceerror.NewInvalidArgument("Task range is not set")
+	errQueueActionNotSet                                  = serviceerror.NewInvalidArgument("Queue action is not set.")
 	errHistoryNotFound                                    = serviceerror.NewInvalidArgument("Requested workflow history not found, may have passed retention period.")
 	errNamespaceTooLong                                   = serviceerror.NewInvalidArgument("Namespace length exceeds limit.")
 	errWorkflowTypeTooLong                                = serviceerror.NewInvalidArgument("WorkflowType length exceeds limit.")
@@ -145,4 +147,8 @@ var (
 	errWorkerVersioningNotAllowed = serviceerror.NewPermissionDenied("Worker versioning is disabled on this namespace.", "")
 
 	errListHistoryTasksNotAllowed = serviceerror.NewPermissionDenied("ListHistoryTasks feature is disabled on this cluster.", "")
+
+	errClusterIsNotConfiguredForHistoryArchival        = serviceerror.NewFailedPrecondition("Cluster is not configured for history archival.")
+	errClusterIsNotConfiguredForReadingArchivalHistory = serviceerror.NewFailedPrecondition("Cluster is not configured for reading archived histories.")
+	errHistoryArchivalNotEnabledForNamespace           = serviceerror.NewFailedPrecondition("History archival is not enabled for the namespace.")
 )
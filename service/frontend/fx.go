@@ -25,10 +25,14 @@
 package frontend
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net"
 
 	"github.com/gorilla/mux"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
@@ -37,6 +41,8 @@ import (
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
@@ -82,6 +88,11 @@ type (
 	namespaceChecker struct {
 		r namespace.Registry
 	}
+
+	workflowTypeResolver struct {
+		namespaceRegistry namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+	}
 )
 
 var Module = fx.Options(
@@ -116,8 +127,11 @@ var Module = fx.Options(
 	service.PersistenceLazyLoadedServiceResolverModule,
 	fx.Provide(FEReplicatorNamespaceReplicationQueueProvider),
 	fx.Provide(AuthorizationInterceptorProvider),
+	fx.Provide(AuthorizationAuditorProvider),
 	fx.Provide(NamespaceCheckerProvider),
+	fx.Provide(WorkflowTypeResolverProvider),
 	fx.Provide(func(so GrpcServerOptions) *grpc.Server { return grpc.NewServer(so.Options...) }),
+	fx.Provide(NamespaceHandlerProvider),
 	fx.Provide(HandlerProvider),
 	fx.Provide(AdminHandlerProvider),
 	fx.Provide(OperatorHandlerProvider),
@@ -181,6 +195,8 @@ func AuthorizationInterceptorProvider(
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
 	audienceGetter authorization.JWTAudienceMapper,
+	workflowTypeResolver authorization.WorkflowTypeResolver,
+	auditor *authorization.Auditor,
 ) *authorization.Interceptor {
 	return authorization.NewInterceptor(
 		claimMapper,
@@ -191,9 +207,25 @@ func AuthorizationInterceptorProvider(
 		audienceGetter,
 		cfg.Global.Authorization.AuthHeaderName,
 		cfg.Global.Authorization.AuthExtraHeaderName,
+		workflowTypeResolver,
+		auditor,
 	)
 }
 
+func AuthorizationAuditorProvider(
+	cfg *config.Config,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+	lc fx.Lifecycle,
+) (*authorization.Auditor, error) {
+	auditor, err := authorization.GetAuditorFromConfig(&cfg.Global.Authorization.Audit, metricsHandler, logger)
+	if err != nil || auditor == nil {
+		return nil, err
+	}
+	lc.Append(fx.StopHook(auditor.Close))
+	return auditor, nil
+}
+
 func NamespaceCheckerProvider(registry namespace.Registry) authorization.NamespaceChecker {
 	return &namespaceChecker{r: registry}
 }
@@ -207,6 +239,43 @@ func (n *namespaceChecker) Exists(name namespace.Name) error {
 	return err
 }
 
+func WorkflowTypeResolverProvider(
+	namespaceRegistry namespace.Registry,
+	historyClient resource.HistoryClient,
+) authorization.WorkflowTypeResolver {
+	return &workflowTypeResolver{
+		namespaceRegistry: namespaceRegistry,
+		historyClient:     historyClient,
+	}
+}
+
+func (r *workflowTypeResolver) ResolveWorkflowType(
+	ctx context.Context,
+	namespaceName namespace.Name,
+	execution *commonpb.WorkflowExecution,
+) (string, error) {
+	namespaceID, err := r.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		var notFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &notFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	resp, err := r.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return resp.GetDatabaseMutableState().GetExecutionInfo().GetWorkflowTypeName(), nil
+}
+
 func GrpcServerOptionsProvider(
 	logger log.Logger,
 	cfg *config.Config,
@@ -439,20 +508,46 @@ func NamespaceRateLimitInterceptorProvider(
 		panic("invalid service name")
 	}
 
+	var namespaceRateCalculator, visibilityRateCalculator calculator.NamespaceCalculator
+	namespaceRateCalculator = calculator.ClusterAwareNamespaceQuotaCalculator{
+		MemberCounter:    frontendServiceResolver,
+		PerInstanceQuota: serviceConfig.MaxNamespaceRPSPerInstance,
+		GlobalQuota:      globalNamespaceRPS,
+	}
+	visibilityRateCalculator = calculator.ClusterAwareNamespaceQuotaCalculator{
+		MemberCounter:    frontendServiceResolver,
+		PerInstanceQuota: serviceConfig.MaxNamespaceVisibilityRPSPerInstance,
+		GlobalQuota:      globalNamespaceVisibilityRPS,
+	}
+	namespaceBurstRatio := serviceConfig.MaxNamespaceBurstRatioPerInstance
+	if serviceName == primitives.FrontendService {
+		// internal frontend has its own RPS limits that aren't overridden by the quotas stored in namespace metadata
+		namespaceRateCalculator = calculator.NamespaceOverrideQuotaCalculator{
+			MemberCounter:  frontendServiceResolver,
+			NamespaceQuota: namespaceQuotaFn(namespaceRegistry, (*persistencespb.NamespaceQuotas).GetFrontendRps),
+			Calculator:     namespaceRateCalculator,
+		}
+		visibilityRateCalculator = calculator.NamespaceOverrideQuotaCalculator{
+			MemberCounter:  frontendServiceResolver,
+			NamespaceQuota: namespaceQuotaFn(namespaceRegistry, (*persistencespb.NamespaceQuotas).GetVisibilityRps),
+			Calculator:     visibilityRateCalculator,
+		}
+		namespaceBurstRatio = func(name string) float64 {
+			if ns, err := namespaceRegistry.GetNamespace(namespace.Name(name)); err == nil {
+				if ratio := ns.Quotas().GetFrontendBurstRatio(); ratio > 0 {
+					return ratio
+				}
+			}
+			return serviceConfig.MaxNamespaceBurstRatioPerInstance(name)
+		}
+	}
+
 	namespaceRateFn := calculator.NewLoggedNamespaceCalculator(
-		calculator.ClusterAwareNamespaceQuotaCalculator{
-			MemberCounter:    frontendServiceResolver,
-			PerInstanceQuota: serviceConfig.MaxNamespaceRPSPerInstance,
-			GlobalQuota:      globalNamespaceRPS,
-		},
+		namespaceRateCalculator,
 		log.With(logger, tag.ComponentRPCHandler, tag.ScopeNamespace),
 	).GetQuota
 	visibilityRateFn := calculator.NewLoggedNamespaceCalculator(
-		calculator.ClusterAwareNamespaceQuotaCalculator{
-			MemberCounter:    frontendServiceResolver,
-			PerInstanceQuota: serviceConfig.MaxNamespaceVisibilityRPSPerInstance,
-			GlobalQuota:      globalNamespaceVisibilityRPS,
-		},
+		visibilityRateCalculator,
 		log.With(logger, tag.ComponentVisibilityHandler, tag.ScopeNamespace),
 	).GetQuota
 	namespaceReplicationInducingRateFn := calculator.NewLoggedNamespaceCalculator(
@@ -466,7 +561,7 @@ func NamespaceRateLimitInterceptorProvider(
 	namespaceRateLimiter := quotas.NewNamespaceRequestRateLimiter(
 		func(req quotas.Request) quotas.RequestRateLimiter {
 			return configs.NewRequestToRateLimiter(
-				configs.NewNamespaceRateBurst(req.Caller, namespaceRateFn, serviceConfig.MaxNamespaceBurstRatioPerInstance),
+				configs.NewNamespaceRateBurst(req.Caller, namespaceRateFn, namespaceBurstRatio),
 				configs.NewNamespaceRateBurst(req.Caller, visibilityRateFn, serviceConfig.MaxNamespaceVisibilityBurstRatioPerInstance),
 				configs.NewNamespaceRateBurst(req.Caller, namespaceReplicationInducingRateFn, serviceConfig.MaxNamespaceNamespaceReplicationInducingAPIsBurstRatioPerInstance),
 				serviceConfig.OperatorRPSRatio,
@@ -488,10 +583,26 @@ func NamespaceCountLimitInterceptorProvider(
 		logger,
 		serviceConfig.MaxConcurrentLongRunningRequestsPerInstance,
 		serviceConfig.MaxGlobalConcurrentLongRunningRequests,
+		namespaceQuotaFn(namespaceRegistry, (*persistencespb.NamespaceQuotas).GetConcurrentLongPollLimit),
 		configs.ExecutionAPICountLimitOverride,
 	)
 }
 
+// namespaceQuotaFn returns a function that reads a quota from the metadata of a namespace. It returns
+// zero if the namespace doesn't exist or has no such quota.
+func namespaceQuotaFn(
+	namespaceRegistry namespace.Registry,
+	quota func(*persistencespb.NamespaceQuotas) int32,
+) func(string) int {
+	return func(name string) int {
+		ns, err := namespaceRegistry.GetNamespace(namespace.Name(name))
+		if err != nil {
+			return 0
+		}
+		return int(quota(ns.Quotas()))
+	}
+}
+
 func NamespaceValidatorInterceptorProvider(
 	serviceConfig *Config,
 	namespaceRegistry namespace.Registry,
@@ -608,6 +719,10 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	archiverProvider provider.ArchiverProvider,
+	archivalMetadata archiver.ArchivalMetadata,
+	namespaceHandler *namespaceHandler,
+	dynamicConfig *dynamicconfig.Collection,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
@@ -636,6 +751,10 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		archiverProvider,
+		archivalMetadata,
+		namespaceHandler,
+		dynamicConfig,
 		taskCategoryRegistry,
 	}
 	return NewAdminHandler(args)
@@ -674,11 +793,35 @@ func OperatorHandlerProvider(
 	return NewOperatorHandlerImpl(args)
 }
 
+// NamespaceHandlerProvider provides the namespace handler that is shared by the workflow and admin handlers.
+func NamespaceHandlerProvider(
+	serviceConfig *Config,
+	logger log.SnTaggedLogger,
+	persistenceMetadataManager persistence.MetadataManager,
+	clusterMetadata cluster.Metadata,
+	namespaceReplicationQueue FEReplicatorNamespaceReplicationQueue,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
+	timeSource clock.TimeSource,
+) *namespaceHandler {
+	return newNamespaceHandler(
+		serviceConfig.MaxBadBinaries,
+		logger,
+		persistenceMetadataManager,
+		clusterMetadata,
+		namespace.NewNamespaceReplicator(namespaceReplicationQueue, logger),
+		archivalMetadata,
+		archiverProvider,
+		serviceConfig.EnableSchedules,
+		timeSource,
+	)
+}
+
 func HandlerProvider(
 	dcRedirectionPolicy config.DCRedirectionPolicy,
 	serviceConfig *Config,
 	versionChecker *VersionChecker,
-	namespaceReplicationQueue FEReplicatorNamespaceReplicationQueue,
+	namespaceHandler *namespaceHandler,
 	visibilityMgr manager.VisibilityManager,
 	logger log.SnTaggedLogger,
 	throttledLogger log.ThrottledLogger,
@@ -704,7 +847,7 @@ func HandlerProvider(
 ) Handler {
 	wfHandler := NewWorkflowHandler(
 		serviceConfig,
-		namespaceReplicationQueue,
+		namespaceHandler,
 		visibilityMgr,
 		logger,
 		throttledLogger,
@@ -638,6 +638,73 @@ func (d *namespaceHandler) UpdateNamespace(
 	return response, nil
 }
 
+// UpdateNamespaceQuotas sets the API quotas of a namespace in the current cluster, nil quotas clear them.
+// Quotas are not replicated, but the config version change is, so that other clusters keep accepting
+// later namespace updates.
+func (d *namespaceHandler) UpdateNamespaceQuotas(
+	ctx context.Context,
+	namespaceName string,
+	quotas *persistencespb.NamespaceQuotas,
+) error {
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	// and since we do not know which table will return the namespace afterwards
+	// this call has to be made
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName})
+	if err != nil {
+		return err
+	}
+
+	info := getResponse.Namespace.Info
+	config := getResponse.Namespace.Config
+	replicationConfig := getResponse.Namespace.ReplicationConfig
+	configVersion := getResponse.Namespace.ConfigVersion + 1
+	config.Quotas = quotas
+	err = d.metadataMgr.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        info,
+			Config:                      config,
+			ReplicationConfig:           replicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   getResponse.IsGlobalNamespace,
+		NotificationVersion: notificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = d.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		info,
+		config,
+		replicationConfig,
+		false,
+		configVersion,
+		getResponse.Namespace.FailoverVersion,
+		getResponse.IsGlobalNamespace,
+		replicationConfig.GetFailoverHistory(),
+	)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Info("Update namespace quotas succeeded",
+		tag.WorkflowNamespace(info.Name),
+		tag.WorkflowNamespaceID(info.Id),
+	)
+	return nil
+}
+
 // DeprecateNamespace deprecates a namespace
 // Deprecated.
 func (d *namespaceHandler) DeprecateNamespace(
@@ -148,7 +148,7 @@ type (
 // NewWorkflowHandler creates a gRPC handler for workflowservice
 func NewWorkflowHandler(
 	config *Config,
-	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
+	namespaceHandler *namespaceHandler,
 	visibilityMgr manager.VisibilityManager,
 	logger log.Logger,
 	throttledLogger log.Logger,
@@ -172,21 +172,11 @@ func NewWorkflowHandler(
 ) *WorkflowHandler {
 
 	handler := &WorkflowHandler{
-		status:          common.DaemonStatusInitialized,
-		config:          config,
-		tokenSerializer: common.NewProtoTaskTokenSerializer(),
-		versionChecker:  headers.NewDefaultVersionChecker(),
-		namespaceHandler: newNamespaceHandler(
-			config.MaxBadBinaries,
-			logger,
-			persistenceMetadataManager,
-			clusterMetadata,
-			namespace.NewNamespaceReplicator(namespaceReplicationQueue, logger),
-			archivalMetadata,
-			archiverProvider,
-			config.EnableSchedules,
-			timeSource,
-		),
+		status:                          common.DaemonStatusInitialized,
+		config:                          config,
+		tokenSerializer:                 common.NewProtoTaskTokenSerializer(),
+		versionChecker:                  headers.NewDefaultVersionChecker(),
+		namespaceHandler:                namespaceHandler,
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
 		visibilityMgr:                   visibilityMgr,
 		logger:                          logger,
@@ -175,7 +175,17 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 	healthInterceptor.SetHealthy(true)
 	return NewWorkflowHandler(
 		config,
-		s.mockProducer,
+		newNamespaceHandler(
+			config.MaxBadBinaries,
+			s.mockResource.GetLogger(),
+			s.mockResource.GetMetadataManager(),
+			s.mockResource.GetClusterMetadata(),
+			namespace.NewNamespaceReplicator(s.mockProducer, s.mockResource.GetLogger()),
+			s.mockResource.GetArchivalMetadata(),
+			s.mockResource.GetArchiverProvider(),
+			config.EnableSchedules,
+			clock.NewRealTimeSource(),
+		),
 		s.mockResource.GetVisibilityManager(),
 		s.mockResource.GetLogger(),
 		s.mockResource.GetThrottledLogger(),
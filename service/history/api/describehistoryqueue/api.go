@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describehistoryqueue
+
+import (
+	"slices"
+	"strings"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Invoke(
+	request *historyservice.DescribeHistoryQueueRequest,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	queueProcessors map[tasks.Category]queues.Queue,
+) (*historyservice.DescribeHistoryQueueResponse, error) {
+	queue, _, err := api.GetOperableQueue(int(request.GetCategory()), taskCategoryRegistry, queueProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	return &historyservice.DescribeHistoryQueueResponse{
+		Response: toAdminDescribeHistoryQueueResponse(queue.Describe()),
+	}, nil
+}
+
+func toAdminDescribeHistoryQueueResponse(
+	description queues.QueueDescription,
+) *adminservice.DescribeHistoryQueueResponse {
+	response := &adminservice.DescribeHistoryQueueResponse{
+		TotalPendingTaskCount: int64(description.TotalPendingTaskCount),
+		TotalSliceCount:       int64(description.TotalSliceCount),
+	}
+
+	for _, reader := range description.Readers {
+		adminReader := &adminservice.HistoryQueueReader{
+			ReaderId:         reader.ReaderID,
+			PendingTaskCount: int64(reader.PendingTaskCount),
+		}
+		for _, slice := range reader.Slices {
+			adminReader.Slices = append(adminReader.Slices, &adminservice.HistoryQueueSlice{
+				Scope:            queues.ToPersistenceScope(slice.Scope),
+				PendingTaskCount: int64(slice.PendingTaskCount),
+			})
+		}
+		if reader.Watermark != nil {
+			adminReader.Watermark = queues.ToPersistenceTaskKey(*reader.Watermark)
+		}
+		response.Readers = append(response.Readers, adminReader)
+	}
+
+	for _, alert := range description.Alerts {
+		response.Alerts = append(response.Alerts, toAdminQueueAlert(alert))
+	}
+	for alertType, expiration := range description.SilencedAlerts {
+		response.Alerts = append(response.Alerts, &adminservice.HistoryQueueAlert{
+			AlertType:     alertType.String(),
+			SilencedUntil: timestamppb.New(expiration),
+		})
+	}
+	slices.SortFunc(response.Alerts, func(a, b *adminservice.HistoryQueueAlert) int {
+		return strings.Compare(a.AlertType, b.AlertType)
+	})
+
+	return response
+}
+
+func toAdminQueueAlert(alert queues.Alert) *adminservice.HistoryQueueAlert {
+	adminAlert := &adminservice.HistoryQueueAlert{
+		AlertType: alert.AlertType.String(),
+	}
+	switch alert.AlertType {
+	case queues.AlertTypeQueuePendingTaskCount:
+		if attributes := alert.AlertAttributesQueuePendingTaskCount; attributes != nil {
+			adminAlert.CurrentCount = int64(attributes.CurrentPendingTaskCount)
+			adminAlert.CriticalCount = int64(attributes.CiriticalPendingTaskCount)
+		}
+	case queues.AlertTypeReaderStuck:
+		if attributes := alert.AlertAttributesReaderStuck; attributes != nil {
+			adminAlert.ReaderId = attributes.ReaderID
+			adminAlert.ReaderWatermark = queues.ToPersistenceTaskKey(attributes.CurrentWatermark)
+		}
+	case queues.AlertTypeSliceCount:
+		if attributes := alert.AlertAttributesSliceCount; attributes != nil {
+			adminAlert.CurrentCount = int64(attributes.CurrentSliceCount)
+			adminAlert.CriticalCount = int64(attributes.CriticalSliceCount)
+		}
+	}
+	return adminAlert
+}
@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executehistoryqueueaction
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.ExecuteHistoryQueueActionRequest,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	queueProcessors map[tasks.Category]queues.Queue,
+) (*historyservice.ExecuteHistoryQueueActionResponse, error) {
+	queue, category, err := api.GetOperableQueue(int(request.GetCategory()), taskCategoryRegistry, queueProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action := request.GetAction().(type) {
+	case *historyservice.ExecuteHistoryQueueActionRequest_Split:
+		var splitRange *queues.Range
+		if taskRange := action.Split.GetTaskRange(); taskRange != nil {
+			inclusiveMin := tasks.MinimumKey
+			if key := taskRange.GetInclusiveMinTaskKey(); key != nil {
+				inclusiveMin = fromHistoryTaskKey(category, key.GetTaskId(), key.GetFireTime())
+			}
+			exclusiveMax := tasks.MaximumKey
+			if key := taskRange.GetExclusiveMaxTaskKey(); key != nil {
+				exclusiveMax = fromHistoryTaskKey(category, key.GetTaskId(), key.GetFireTime())
+			}
+			r := queues.NewRange(inclusiveMin, exclusiveMax)
+			splitRange = &r
+		}
+		err = queue.SplitSlices(ctx, action.Split.GetReaderId(), splitRange)
+	case *historyservice.ExecuteHistoryQueueActionRequest_Shrink:
+		err = queue.ShrinkSlices(ctx)
+	case *historyservice.ExecuteHistoryQueueActionRequest_Pause:
+		err = queue.PauseReader(ctx, action.Pause.GetReaderId(), timestamp.DurationValue(action.Pause.GetDuration()))
+	default:
+		err = serviceerror.NewInvalidArgument("queue action is not set")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.ExecuteHistoryQueueActionResponse{}, nil
+}
+
+func fromHistoryTaskKey(
+	category tasks.Category,
+	taskID int64,
+	fireTime *timestamppb.Timestamp,
+) tasks.Key {
+	if category.Type() == tasks.CategoryTypeImmediate {
+		return tasks.NewImmediateKey(taskID)
+	}
+	return tasks.NewKey(timestamp.TimeValue(fireTime), taskID)
+}
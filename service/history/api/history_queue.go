@@ -0,0 +1,61 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// GetOperableQueue returns the queue processor of the given task category,
+// if the queue supports being inspected and operated on manually.
+func GetOperableQueue(
+	categoryID int,
+	registry tasks.TaskCategoryRegistry,
+	queueProcessors map[tasks.Category]queues.Queue,
+) (queues.Operable, tasks.Category, error) {
+	category, err := GetTaskCategory(categoryID, registry)
+	if err != nil {
+		return nil, tasks.Category{}, err
+	}
+
+	queue, ok := queueProcessors[category]
+	if !ok {
+		return nil, tasks.Category{}, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("No queue processor for task category: %v", category.Name()),
+		)
+	}
+	operable, ok := queue.(queues.Operable)
+	if !ok {
+		return nil, tasks.Category{}, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("Queue for task category %v does not support queue operations", category.Name()),
+		)
+	}
+	return operable, category, nil
+}
@@ -96,9 +96,12 @@ func newHostScheduler(params ArchivalQueueFactoryParams) queues.Scheduler {
 			WorkerCount:             params.Config.ArchivalProcessorSchedulerWorkerCount,
 			ActiveNamespaceWeights:  dynamicconfig.GetMapPropertyFnFilteredByNamespace(ArchivalTaskPriorities),
 			StandbyNamespaceWeights: dynamicconfig.GetMapPropertyFnFilteredByNamespace(ArchivalTaskPriorities),
+			NamespacePriorityTier:   params.Config.TaskSchedulerNamespacePriorityTier,
+			PriorityTierWeights:     params.Config.TaskSchedulerPriorityTierWeights,
 		},
 		params.NamespaceRegistry,
 		params.Logger,
+		params.MetricsHandler,
 	)
 }
 
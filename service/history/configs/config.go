@@ -124,6 +124,8 @@ type Config struct {
 	TaskSchedulerMaxQPS                      dynamicconfig.IntPropertyFn
 	TaskSchedulerGlobalNamespaceMaxQPS       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	TaskSchedulerNamespaceMaxQPS             dynamicconfig.IntPropertyFnWithNamespaceFilter
+	TaskSchedulerNamespacePriorityTier       dynamicconfig.TypedSubscribableWithNamespaceFilter[string]
+	TaskSchedulerPriorityTierWeights         dynamicconfig.TypedSubscribable[map[string]int]
 
 	// TimerQueueProcessor settings
 	TimerTaskBatchSize                               dynamicconfig.IntPropertyFn
@@ -458,6 +460,8 @@ func NewConfig(
 		TaskSchedulerMaxQPS:                      dynamicconfig.TaskSchedulerMaxQPS.Get(dc),
 		TaskSchedulerNamespaceMaxQPS:             dynamicconfig.TaskSchedulerNamespaceMaxQPS.Get(dc),
 		TaskSchedulerGlobalNamespaceMaxQPS:       dynamicconfig.TaskSchedulerGlobalNamespaceMaxQPS.Get(dc),
+		TaskSchedulerNamespacePriorityTier:       dynamicconfig.TaskSchedulerNamespacePriorityTier.Subscribe(dc),
+		TaskSchedulerPriorityTierWeights:         dynamicconfig.TaskSchedulerPriorityTierWeights.Subscribe(dc),
 
 		TimerTaskBatchSize:                               dynamicconfig.TimerTaskBatchSize.Get(dc),
 		TimerProcessorSchedulerWorkerCount:               dynamicconfig.TimerProcessorSchedulerWorkerCount.Subscribe(dc),
@@ -99,6 +99,8 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/AddTasks":                               1,
 		"/temporal.server.api.historyservice.v1.HistoryService/ListQueues":                             1,
 		"/temporal.server.api.historyservice.v1.HistoryService/ListTasks":                              1,
+		"/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryQueue":                   1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ExecuteHistoryQueueAction":              1,
 		"/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation":                 1,
 		"/temporal.server.api.historyservice.v1.HistoryService/InvokeStateMachineMethod":               1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DeepHealthCheck":                        1,
@@ -2307,6 +2307,60 @@ func (h *Handler) ListTasks(
 	return resp, nil
 }
 
+func (h *Handler) DescribeHistoryQueue(
+	ctx context.Context,
+	request *historyservice.DescribeHistoryQueueRequest,
+) (_ *historyservice.DescribeHistoryQueueResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.DescribeHistoryQueue(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) ExecuteHistoryQueueAction(
+	ctx context.Context,
+	request *historyservice.ExecuteHistoryQueueActionRequest,
+) (_ *historyservice.ExecuteHistoryQueueActionResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.ExecuteHistoryQueueAction(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 func (h *Handler) CompleteNexusOperation(ctx context.Context, request *historyservice.CompleteNexusOperationRequest) (_ *historyservice.CompleteNexusOperationResponse, retErr error) {
 	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retErr)
 	h.startWG.Wait()
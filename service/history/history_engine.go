@@ -59,8 +59,10 @@ import (
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/api/addtasks"
 	"go.temporal.io/server/service/history/api/deleteworkflow"
+	"go.temporal.io/server/service/history/api/describehistoryqueue"
 	"go.temporal.io/server/service/history/api/describemutablestate"
 	"go.temporal.io/server/service/history/api/describeworkflow"
+	"go.temporal.io/server/service/history/api/executehistoryqueueaction"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistory"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistoryreverse"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistory"
@@ -778,6 +780,7 @@ func (e *historyEngineImpl) ImportWorkflowExecution(
 		request.VersionHistory.Items,
 		historyEvents,
 		request.Token,
+		request.RestoreFromArchive,
 	)
 	if err != nil {
 		return nil, err
@@ -1021,6 +1024,29 @@ func (e *historyEngineImpl) ListTasks(
 	)
 }
 
+func (e *historyEngineImpl) DescribeHistoryQueue(
+	_ context.Context,
+	request *historyservice.DescribeHistoryQueueRequest,
+) (_ *historyservice.DescribeHistoryQueueResponse, retError error) {
+	return describehistoryqueue.Invoke(
+		request,
+		e.taskCategoryRegistry,
+		e.queueProcessors,
+	)
+}
+
+func (e *historyEngineImpl) ExecuteHistoryQueueAction(
+	ctx context.Context,
+	request *historyservice.ExecuteHistoryQueueActionRequest,
+) (_ *historyservice.ExecuteHistoryQueueActionResponse, retError error) {
+	return executehistoryqueueaction.Invoke(
+		ctx,
+		request,
+		e.taskCategoryRegistry,
+		e.queueProcessors,
+	)
+}
+
 // StateMachineEnvironment implements shard.Engine.
 func (e *historyEngineImpl) StateMachineEnvironment() hsm.Environment {
 	return e.stateMachineEnvironment
@@ -0,0 +1,63 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"time"
+)
+
+var _ Action = (*actionPauseReader)(nil)
+
+type (
+	// actionPauseReader stops a reader from loading tasks for
+	// the given duration upon operator request.
+	actionPauseReader struct {
+		readerID int64
+		duration time.Duration
+	}
+)
+
+func newPauseReaderAction(
+	readerID int64,
+	duration time.Duration,
+) *actionPauseReader {
+	return &actionPauseReader{
+		readerID: readerID,
+		duration: duration,
+	}
+}
+
+func (a *actionPauseReader) Name() string {
+	return "pause-reader"
+}
+
+func (a *actionPauseReader) Run(readerGroup *ReaderGroup) {
+	reader, ok := readerGroup.ReaderByID(a.readerID)
+	if !ok {
+		return
+	}
+
+	reader.Pause(a.duration)
+}
@@ -68,42 +68,51 @@ func (a *actionReaderStuck) Run(readerGroup *ReaderGroup) {
 		),
 	)
 
+	splitSlices := splitSlicesByRange(reader, stuckRange)
+	if len(splitSlices) == 0 {
+		return
+	}
+
+	nextReader := readerGroup.GetOrCreateReader(a.attributes.ReaderID + 1)
+	nextReader.MergeSlices(splitSlices...)
+}
+
+// splitSlicesByRange splits slices of the reader at the boundaries of the given range,
+// removes the parts within the range from the reader and returns them.
+func splitSlicesByRange(
+	reader Reader,
+	splitRange Range,
+) []Slice {
 	var splitSlices []Slice
 	reader.SplitSlices(func(s Slice) ([]Slice, bool) {
 		r := s.Scope().Range
-		if stuckRange.ContainsRange(r) {
+		if splitRange.ContainsRange(r) {
 			splitSlices = append(splitSlices, s)
 			return nil, true
 		}
 
 		remaining := make([]Slice, 0, 2)
-		if s.CanSplitByRange(stuckRange.InclusiveMin) {
-			left, right := s.SplitByRange(stuckRange.InclusiveMin)
+		if s.CanSplitByRange(splitRange.InclusiveMin) {
+			left, right := s.SplitByRange(splitRange.InclusiveMin)
 			remaining = append(remaining, left)
 			s = right
 		}
 
-		if s.CanSplitByRange(stuckRange.ExclusiveMax) {
-			left, right := s.SplitByRange(stuckRange.ExclusiveMax)
+		if s.CanSplitByRange(splitRange.ExclusiveMax) {
+			left, right := s.SplitByRange(splitRange.ExclusiveMax)
 			remaining = append(remaining, right)
 			s = left
 		}
 
 		if len(remaining) == 0 {
-			// s can't split by both min and max of stuck range,
-			// and stuck range does not contain the range of s,
-			// the only possible case is s is not overlapping with stuck range at all.
+			// s can't split by both min and max of split range,
+			// and split range does not contain the range of s,
+			// the only possible case is s is not overlapping with split range at all.
 			return nil, false
 		}
 
 		splitSlices = append(splitSlices, s)
 		return remaining, true
 	})
-
-	if len(splitSlices) == 0 {
-		return
-	}
-
-	nextReader := readerGroup.GetOrCreateReader(a.attributes.ReaderID + 1)
-	nextReader.MergeSlices(splitSlices...)
+	return splitSlices
 }
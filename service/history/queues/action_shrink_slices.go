@@ -0,0 +1,47 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+var _ Action = (*actionShrinkSlices)(nil)
+
+type (
+	// actionShrinkSlices shrinks the scope of all slices upon operator request,
+	// so that completed tasks are released without waiting for the next checkpoint.
+	actionShrinkSlices struct{}
+)
+
+func newShrinkSlicesAction() *actionShrinkSlices {
+	return &actionShrinkSlices{}
+}
+
+func (a *actionShrinkSlices) Name() string {
+	return "shrink-slices"
+}
+
+func (a *actionShrinkSlices) Run(readerGroup *ReaderGroup) {
+	readerGroup.ForEach(func(_ int64, r Reader) {
+		r.ShrinkSlices()
+	})
+}
@@ -99,20 +99,28 @@ func (a *slicePredicateAction) Run(readerGroup *ReaderGroup) {
 		return
 	}
 
-	var moveSlices []Slice
+	moveSlices := splitNonUniversalSlices(reader)
+	if len(moveSlices) == 0 {
+		return
+	}
+
+	nextReader := readerGroup.GetOrCreateReader(DefaultReaderId + 1)
+	nextReader.MergeSlices(moveSlices...)
+}
+
+// splitNonUniversalSlices removes slices with a non-universal predicate
+// from the reader and returns them.
+func splitNonUniversalSlices(
+	reader Reader,
+) []Slice {
+	var splitSlices []Slice
 	reader.SplitSlices(func(s Slice) (remaining []Slice, split bool) {
 		if tasks.IsUniverisalPredicate(s.Scope().Predicate) {
 			return []Slice{s}, false
 		}
 
-		moveSlices = append(moveSlices, s)
+		splitSlices = append(splitSlices, s)
 		return nil, true
 	})
-
-	if len(moveSlices) == 0 {
-		return
-	}
-
-	nextReader := readerGroup.GetOrCreateReader(DefaultReaderId + 1)
-	nextReader.MergeSlices(moveSlices...)
+	return splitSlices
 }
@@ -0,0 +1,72 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+var _ Action = (*actionSplitSlices)(nil)
+
+type (
+	// actionSplitSlices moves slices of a reader to the next reader upon operator request.
+	// When a range is given, slices are split the same way as for a stuck reader,
+	// otherwise slices with a non-universal predicate are moved, same as slicePredicateAction.
+	actionSplitSlices struct {
+		readerID   int64
+		splitRange *Range
+	}
+)
+
+func newSplitSlicesAction(
+	readerID int64,
+	splitRange *Range,
+) *actionSplitSlices {
+	return &actionSplitSlices{
+		readerID:   readerID,
+		splitRange: splitRange,
+	}
+}
+
+func (a *actionSplitSlices) Name() string {
+	return "split-slices"
+}
+
+func (a *actionSplitSlices) Run(readerGroup *ReaderGroup) {
+	reader, ok := readerGroup.ReaderByID(a.readerID)
+	if !ok {
+		return
+	}
+
+	var splitSlices []Slice
+	if a.splitRange != nil {
+		splitSlices = splitSlicesByRange(reader, *a.splitRange)
+	} else {
+		splitSlices = splitNonUniversalSlices(reader)
+	}
+
+	if len(splitSlices) == 0 {
+		return
+	}
+
+	nextReader := readerGroup.GetOrCreateReader(a.readerID + 1)
+	nextReader.MergeSlices(splitSlices...)
+}
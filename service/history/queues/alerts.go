@@ -61,3 +61,16 @@ const (
 	AlertTypeReaderStuck
 	AlertTypeSliceCount
 )
+
+func (t AlertType) String() string {
+	switch t {
+	case AlertTypeQueuePendingTaskCount:
+		return "queue-pending-task-count"
+	case AlertTypeReaderStuck:
+		return "reader-stuck"
+	case AlertTypeSliceCount:
+		return "slice-count"
+	default:
+		return "unspecified"
+	}
+}
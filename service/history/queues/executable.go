@@ -64,6 +64,7 @@ type (
 
 		Attempt() int
 		GetTask() tasks.Task
+		GetNamespaceName() namespace.Name
 		GetPriority() ctasks.Priority
 		GetScheduledTime() time.Time
 		SetScheduledTime(time.Time)
@@ -159,6 +160,10 @@ type (
 		metricsHandler    metrics.Handler
 		dlqWriter         *DLQWriter
 
+		// namespace name is resolved on first use and cached for the lifetime of the executable
+		namespaceNameOnce sync.Once
+		namespaceName     namespace.Name
+
 		readerID                   int64
 		loadTime                   time.Time
 		scheduledTime              time.Time
@@ -259,7 +264,7 @@ func (e *executableImpl) Execute() (retErr error) {
 		return nil
 	}
 
-	ns, _ := e.namespaceRegistry.GetNamespaceName(namespace.ID(e.GetNamespaceID()))
+	ns := e.GetNamespaceName()
 	var callerInfo headers.CallerInfo
 	switch e.priority {
 	case ctasks.PriorityHigh:
@@ -669,6 +674,14 @@ func (e *executableImpl) GetTask() tasks.Task {
 	return e.Task
 }
 
+// GetNamespaceName returns the name of the task's namespace, or an empty name if the namespace is not found.
+func (e *executableImpl) GetNamespaceName() namespace.Name {
+	e.namespaceNameOnce.Do(func() {
+		e.namespaceName, _ = e.namespaceRegistry.GetNamespaceName(namespace.ID(e.GetNamespaceID()))
+	})
+	return e.namespaceName
+}
+
 func (e *executableImpl) GetScheduledTime() time.Time {
 	return e.scheduledTime
 }
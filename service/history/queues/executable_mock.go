@@ -36,6 +36,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	v1 "go.temporal.io/server/api/enums/v1"
 	backoff "go.temporal.io/server/common/backoff"
+	namespace "go.temporal.io/server/common/namespace"
 	tasks "go.temporal.io/server/common/tasks"
 	tasks0 "go.temporal.io/server/service/history/tasks"
 )
@@ -169,6 +170,20 @@ func (mr *MockExecutableMockRecorder) GetNamespaceID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceID", reflect.TypeOf((*MockExecutable)(nil).GetNamespaceID))
 }
 
+// GetNamespaceName mocks base method.
+func (m *MockExecutable) GetNamespaceName() namespace.Name {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceName")
+	ret0, _ := ret[0].(namespace.Name)
+	return ret0
+}
+
+// GetNamespaceName indicates an expected call of GetNamespaceName.
+func (mr *MockExecutableMockRecorder) GetNamespaceName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceName", reflect.TypeOf((*MockExecutable)(nil).GetNamespaceName))
+}
+
 // GetPriority mocks base method.
 func (m *MockExecutable) GetPriority() tasks.Priority {
 	m.ctrl.T.Helper()
@@ -85,7 +85,7 @@ type (
 		timeSource   clock.TimeSource
 		options      *MonitorOptions
 
-		pendingAlerts  map[AlertType]struct{}
+		pendingAlerts  map[AlertType]*Alert
 		silencedAlerts map[AlertType]time.Time // silenced alertType => expiration
 		alertCh        chan *Alert
 		shutdownCh     chan struct{}
@@ -117,7 +117,7 @@ func newMonitor(
 		categoryType:   categoryType,
 		timeSource:     timeSource,
 		options:        options,
-		pendingAlerts:  make(map[AlertType]struct{}),
+		pendingAlerts:  make(map[AlertType]*Alert),
 		silencedAlerts: make(map[AlertType]time.Time),
 		alertCh:        make(chan *Alert, alertChSize),
 		shutdownCh:     make(chan struct{}),
@@ -297,6 +297,34 @@ func (m *monitorImpl) SilenceAlert(alertType AlertType) {
 	m.silencedAlerts[alertType] = m.timeSource.Now().Add(defaultAlertSilenceDuration)
 }
 
+// GetPendingAlerts returns the alerts that are sent but not yet resolved.
+func (m *monitorImpl) GetPendingAlerts() []Alert {
+	m.Lock()
+	defer m.Unlock()
+
+	alerts := make([]Alert, 0, len(m.pendingAlerts))
+	for _, alert := range m.pendingAlerts {
+		alerts = append(alerts, *alert)
+	}
+	return alerts
+}
+
+// GetSilencedAlerts returns the alert types that are currently silenced
+// and when the silence expires.
+func (m *monitorImpl) GetSilencedAlerts() map[AlertType]time.Time {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.timeSource.Now()
+	silencedAlerts := make(map[AlertType]time.Time, len(m.silencedAlerts))
+	for alertType, expiration := range m.silencedAlerts {
+		if now.Before(expiration) {
+			silencedAlerts[alertType] = expiration
+		}
+	}
+	return silencedAlerts
+}
+
 func (m *monitorImpl) AlertCh() <-chan *Alert {
 	return m.alertCh
 }
@@ -335,7 +363,7 @@ func (m *monitorImpl) sendAlertLocked(alert *Alert) {
 
 	select {
 	case m.alertCh <- alert:
-		m.pendingAlerts[alert.AlertType] = struct{}{}
+		m.pendingAlerts[alert.AlertType] = alert
 	default:
 		// do not block if alertCh full
 	}
@@ -25,6 +25,9 @@
 package queues
 
 import (
+	"context"
+	"time"
+
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -38,4 +41,37 @@ type (
 		Start()
 		Stop()
 	}
+
+	// Operable is implemented by queues that load tasks with readers and slices.
+	// It allows operators to inspect the queue state and run queue actions manually.
+	Operable interface {
+		Describe() QueueDescription
+		SplitSlices(ctx context.Context, readerID int64, taskRange *Range) error
+		ShrinkSlices(ctx context.Context) error
+		PauseReader(ctx context.Context, readerID int64, duration time.Duration) error
+	}
+
+	// QueueDescription is the in-memory state of an Operable queue.
+	QueueDescription struct {
+		TotalPendingTaskCount int
+		TotalSliceCount       int
+		Readers               []ReaderDescription
+		// Alerts that are sent but not yet resolved
+		Alerts []Alert
+		// Alert types that are silenced, with the time the silence expires
+		SilencedAlerts map[AlertType]time.Time
+	}
+
+	ReaderDescription struct {
+		ReaderID         int64
+		Slices           []SliceDescription
+		PendingTaskCount int
+		// Watermark is nil if the reader has not loaded any tasks yet
+		Watermark *tasks.Key
+	}
+
+	SliceDescription struct {
+		Scope            Scope
+		PendingTaskCount int
+	}
 )
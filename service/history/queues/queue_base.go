@@ -26,9 +26,12 @@ package queues
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"sync"
 	"time"
 
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
@@ -105,7 +108,13 @@ type (
 		checkpointRetrier backoff.Retrier
 		checkpointTimer   *time.Timer
 
-		alertCh <-chan *Alert
+		alertCh  <-chan *Alert
+		actionCh chan *queueActionRequest
+	}
+
+	queueActionRequest struct {
+		action Action
+		doneCh chan struct{}
 	}
 
 	Options struct {
@@ -237,7 +246,8 @@ func newQueueBase(
 			clock.NewRealTimeSource(),
 		),
 
-		alertCh: monitor.AlertCh(),
+		alertCh:  monitor.AlertCh(),
+		actionCh: make(chan *queueActionRequest),
 	}
 }
 
@@ -439,6 +449,128 @@ func (p *queueBase) handleAlert(alert *Alert) {
 	p.notifyReaders()
 }
 
+// Describe returns the in-memory state of the queue.
+func (p *queueBase) Describe() QueueDescription {
+	description := QueueDescription{
+		TotalPendingTaskCount: p.monitor.GetTotalPendingTaskCount(),
+		TotalSliceCount:       p.monitor.GetTotalSliceCount(),
+		Alerts:                p.monitor.GetPendingAlerts(),
+		SilencedAlerts:        p.monitor.GetSilencedAlerts(),
+	}
+
+	readers := p.readerGroup.Readers()
+	readerIDs := make([]int64, 0, len(readers))
+	for readerID := range readers {
+		readerIDs = append(readerIDs, readerID)
+	}
+	slices.Sort(readerIDs)
+
+	for _, readerID := range readerIDs {
+		reader := ReaderDescription{
+			ReaderID: readerID,
+		}
+		readers[readerID].WalkSlices(func(s Slice) {
+			pendingTaskCount := p.monitor.GetSlicePendingTaskCount(s)
+			reader.Slices = append(reader.Slices, SliceDescription{
+				Scope:            s.Scope(),
+				PendingTaskCount: pendingTaskCount,
+			})
+			reader.PendingTaskCount += pendingTaskCount
+		})
+		if watermark, ok := p.monitor.GetReaderWatermark(readerID); ok {
+			reader.Watermark = &watermark
+		}
+		description.Readers = append(description.Readers, reader)
+	}
+
+	return description
+}
+
+// SplitSlices moves the slices of a reader, or the part of them within taskRange if
+// it's not nil, to the next reader.
+func (p *queueBase) SplitSlices(
+	ctx context.Context,
+	readerID int64,
+	taskRange *Range,
+) error {
+	if _, ok := p.readerGroup.ReaderByID(readerID); !ok {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("reader %v not found", readerID))
+	}
+	if maxReaderCount := int64(p.options.MaxReaderCount()); readerID+1 >= maxReaderCount {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"unable to split slices of reader %v, max reader count is %v", readerID, maxReaderCount,
+		))
+	}
+	if taskRange != nil && taskRange.InclusiveMin.CompareTo(taskRange.ExclusiveMax) >= 0 {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"invalid task range, min %v is not less than max %v", taskRange.InclusiveMin, taskRange.ExclusiveMax,
+		))
+	}
+	return p.executeAction(ctx, newSplitSlicesAction(readerID, taskRange))
+}
+
+// ShrinkSlices shrinks the range of all slices to the tasks they still track.
+func (p *queueBase) ShrinkSlices(ctx context.Context) error {
+	return p.executeAction(ctx, newShrinkSlicesAction())
+}
+
+// PauseReader stops a reader from loading tasks for the given duration.
+func (p *queueBase) PauseReader(
+	ctx context.Context,
+	readerID int64,
+	duration time.Duration,
+) error {
+	if _, ok := p.readerGroup.ReaderByID(readerID); !ok {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("reader %v not found", readerID))
+	}
+	if duration <= 0 {
+		return serviceerror.NewInvalidArgument("pause duration must be positive")
+	}
+	return p.executeAction(ctx, newPauseReaderAction(readerID, duration))
+}
+
+// executeAction runs the action on the queue. The action is run by the queue event
+// loop, the same way as actions for resolving alerts.
+func (p *queueBase) executeAction(
+	ctx context.Context,
+	action Action,
+) error {
+	actionRequest := &queueActionRequest{
+		action: action,
+		doneCh: make(chan struct{}),
+	}
+	select {
+	case p.actionCh <- actionRequest:
+	case <-p.shutdownCh:
+		return serviceerror.NewUnavailable("queue is shutting down")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-actionRequest.doneCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *queueBase) handleAction(request *queueActionRequest) {
+	defer close(request.doneCh)
+
+	p.logger.Info("Running queue action requested by operator", tag.QueueAction(request.action.Name()))
+	runAction(request.action, p.readerGroup, p.metricsHandler, p.logger)
+
+	// checkpoint the action taken & update reader progress
+	p.checkpoint()
+
+	if _, ok := request.action.(*actionPauseReader); ok {
+		// notifying readers would resume the paused reader
+		return
+	}
+	p.notifyReaders()
+}
+
 func (p *queueBase) notifyReaders() {
 	p.readerGroup.ForEach(func(_ int64, r Reader) {
 		r.Notify()
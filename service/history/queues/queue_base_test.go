@@ -37,6 +37,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
@@ -534,6 +535,140 @@ func (s *queueBaseSuite) TestCheckPoint_MoveSlices() {
 	s.True(scopes[0].Range.InclusiveMin.CompareTo(base.exclusiveDeletionHighWatermark) == 0)
 }
 
+func (s *queueBaseSuite) TestDescribe() {
+	scopes := NewRandomScopes(3)
+	scopes[2].Predicate = tasks.NewTypePredicate([]enumsspb.TaskType{enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER})
+	initialQueueState := &queueState{
+		readerScopes: map[int64][]Scope{
+			DefaultReaderId:     scopes[:2],
+			DefaultReaderId + 1: scopes[2:],
+		},
+		exclusiveReaderHighWatermark: tasks.MaximumKey,
+	}
+
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+			QueueStates: map[int32]*persistencespb.QueueState{
+				int32(tasks.CategoryIDTimer): ToPersistenceQueueState(initialQueueState),
+			},
+		},
+		s.config,
+	)
+
+	base := s.newQueueBase(mockShard, tasks.CategoryTimer, nil)
+	response := base.Describe()
+
+	s.Len(response.Readers, 2)
+	for idx, readerID := range []int64{DefaultReaderId, DefaultReaderId + 1} {
+		reader := response.Readers[idx]
+		s.Equal(readerID, reader.ReaderID)
+
+		expectedScopes := initialQueueState.readerScopes[readerID]
+		s.Len(reader.Slices, len(expectedScopes))
+		for i, slice := range reader.Slices {
+			s.True(expectedScopes[i].Equals(slice.Scope))
+		}
+	}
+}
+
+func (s *queueBaseSuite) TestExecuteAction_InvalidRequest() {
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+		},
+		s.config,
+	)
+
+	base := s.newQueueBase(mockShard, tasks.CategoryTransfer, nil)
+	base.readerGroup.NewReader(DefaultReaderId)
+
+	invalidRange := NewRange(tasks.NewImmediateKey(100), tasks.NewImmediateKey(100))
+	testCases := []func() error{
+		func() error {
+			return base.SplitSlices(context.Background(), DefaultReaderId+1, nil)
+		},
+		func() error {
+			return base.SplitSlices(context.Background(), DefaultReaderId, &invalidRange)
+		},
+		func() error {
+			return base.PauseReader(context.Background(), DefaultReaderId+1, time.Minute)
+		},
+		func() error {
+			return base.PauseReader(context.Background(), DefaultReaderId, 0)
+		},
+	}
+
+	for _, executeAction := range testCases {
+		err := executeAction()
+		var invalidArgErr *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgErr)
+	}
+}
+
+func (s *queueBaseSuite) TestHandleAction_SplitSlices() {
+	exclusiveReaderHighWatermark := tasks.MaximumKey
+	scopes := NewRandomScopes(3)
+	scopes[0].Predicate = tasks.NewNamespacePredicate([]string{uuid.New()})
+	scopes[2].Predicate = tasks.NewTypePredicate([]enumsspb.TaskType{enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER})
+	initialQueueState := &queueState{
+		readerScopes: map[int64][]Scope{
+			DefaultReaderId: scopes,
+		},
+		exclusiveReaderHighWatermark: exclusiveReaderHighWatermark,
+	}
+
+	expectedQueueState := &queueState{
+		readerScopes: map[int64][]Scope{
+			DefaultReaderId:     {scopes[1]},
+			DefaultReaderId + 1: {scopes[0], scopes[2]},
+		},
+		exclusiveReaderHighWatermark: exclusiveReaderHighWatermark,
+	}
+	expectedPersistenceState := ToPersistenceQueueState(expectedQueueState)
+
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+			QueueStates: map[int32]*persistencespb.QueueState{
+				int32(tasks.CategoryIDTimer): ToPersistenceQueueState(initialQueueState),
+			},
+		},
+		s.config,
+	)
+	mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	mockShard.Resource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+
+	base := s.newQueueBase(mockShard, tasks.CategoryTimer, nil)
+	base.checkpointTimer = time.NewTimer(s.options.CheckpointInterval())
+
+	mockShard.Resource.ExecutionMgr.EXPECT().RangeCompleteHistoryTasks(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockShard.Resource.ShardMgr.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateShardRequest) error {
+			s.QueueStateEqual(expectedPersistenceState, request.ShardInfo.QueueStates[int32(tasks.CategoryIDTimer)])
+			return nil
+		},
+	).Times(1)
+
+	request := &queueActionRequest{
+		action: newSplitSlicesAction(DefaultReaderId, nil),
+		doneCh: make(chan struct{}),
+	}
+	base.handleAction(request)
+
+	select {
+	case <-request.doneCh:
+	default:
+		s.Fail("action request should be marked as done")
+	}
+}
+
 func (s *queueBaseSuite) QueueStateEqual(
 	this *persistencespb.QueueState,
 	that *persistencespb.QueueState,
@@ -41,6 +41,7 @@ import (
 )
 
 var _ Queue = (*immediateQueue)(nil)
+var _ Operable = (*immediateQueue)(nil)
 
 type (
 	immediateQueue struct {
@@ -173,6 +174,8 @@ func (p *immediateQueue) processEventLoop() {
 			p.checkpoint()
 		case alert := <-p.alertCh:
 			p.handleAlert(alert)
+		case request := <-p.actionCh:
+			p.handleAction(request)
 		}
 	}
 }
@@ -29,7 +29,9 @@
 package queues
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	tasks "go.temporal.io/server/service/history/tasks"
@@ -119,3 +121,82 @@ func (mr *MockQueueMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockQueue)(nil).Stop))
 }
+
+// MockOperable is a mock of Operable interface.
+type MockOperable struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperableMockRecorder
+}
+
+// MockOperableMockRecorder is the mock recorder for MockOperable.
+type MockOperableMockRecorder struct {
+	mock *MockOperable
+}
+
+// NewMockOperable creates a new mock instance.
+func NewMockOperable(ctrl *gomock.Controller) *MockOperable {
+	mock := &MockOperable{ctrl: ctrl}
+	mock.recorder = &MockOperableMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOperable) EXPECT() *MockOperableMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockOperable) Describe() QueueDescription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(QueueDescription)
+	return ret0
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockOperableMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockOperable)(nil).Describe))
+}
+
+// PauseReader mocks base method.
+func (m *MockOperable) PauseReader(ctx context.Context, readerID int64, duration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseReader", ctx, readerID, duration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseReader indicates an expected call of PauseReader.
+func (mr *MockOperableMockRecorder) PauseReader(ctx, readerID, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseReader", reflect.TypeOf((*MockOperable)(nil).PauseReader), ctx, readerID, duration)
+}
+
+// ShrinkSlices mocks base method.
+func (m *MockOperable) ShrinkSlices(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShrinkSlices", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShrinkSlices indicates an expected call of ShrinkSlices.
+func (mr *MockOperableMockRecorder) ShrinkSlices(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShrinkSlices", reflect.TypeOf((*MockOperable)(nil).ShrinkSlices), ctx)
+}
+
+// SplitSlices mocks base method.
+func (m *MockOperable) SplitSlices(ctx context.Context, readerID int64, taskRange *Range) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitSlices", ctx, readerID, taskRange)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SplitSlices indicates an expected call of SplitSlices.
+func (mr *MockOperableMockRecorder) SplitSlices(ctx, readerID, taskRange interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitSlices", reflect.TypeOf((*MockOperable)(nil).SplitSlices), ctx, readerID, taskRange)
+}
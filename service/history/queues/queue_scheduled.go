@@ -44,6 +44,7 @@ import (
 )
 
 var _ Queue = (*scheduledQueue)(nil)
+var _ Operable = (*scheduledQueue)(nil)
 
 type (
 	scheduledQueue struct {
@@ -219,6 +220,8 @@ func (p *scheduledQueue) processEventLoop() {
 			p.checkpoint()
 		case alert := <-p.alertCh:
 			p.handleAlert(alert)
+		case request := <-p.actionCh:
+			p.handleAction(request)
 		}
 	}
 }
@@ -109,6 +109,7 @@ func (s *scheduledQueueSuite) SetupTest() {
 		},
 		s.mockShard.GetNamespaceRegistry(),
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	scheduler = NewRateLimitedScheduler(
 		scheduler,
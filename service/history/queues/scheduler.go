@@ -27,6 +27,8 @@
 package queues
 
 import (
+	"sync/atomic"
+
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -45,6 +47,10 @@ const (
 	prioritySchedulerProcessorQueueSize = 10
 
 	taskSchedulerToken = 1
+
+	// one in every priorityTierMetricSampleRate submitted tasks is recorded in the
+	// priority tier request metric, with a count of priorityTierMetricSampleRate
+	priorityTierMetricSampleRate = 16
 )
 
 type (
@@ -73,6 +79,11 @@ type (
 		WorkerCount             dynamicconfig.TypedSubscribable[int]
 		ActiveNamespaceWeights  dynamicconfig.MapPropertyFnWithNamespaceFilter
 		StandbyNamespaceWeights dynamicconfig.MapPropertyFnWithNamespaceFilter
+		// NamespacePriorityTier and PriorityTierWeights are optional. When set, the
+		// weights of a namespace's task channels are multiplied by the weight of the
+		// priority tier the namespace belongs to.
+		NamespacePriorityTier dynamicconfig.TypedSubscribableWithNamespaceFilter[string]
+		PriorityTierWeights   dynamicconfig.TypedSubscribable[map[string]int]
 	}
 
 	RateLimitedSchedulerOptions struct {
@@ -83,6 +94,9 @@ type (
 	schedulerImpl struct {
 		tasks.Scheduler[Executable]
 		namespaceRegistry namespace.Registry
+		priorityTiers     *priorityTiers
+		metricsHandler    metrics.Handler
+		submitCount       atomic.Int64
 
 		taskChannelKeyFn      TaskChannelKeyFn
 		channelWeightFn       ChannelWeightFn
@@ -101,9 +115,18 @@ func NewScheduler(
 	options SchedulerOptions,
 	namespaceRegistry namespace.Registry,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) Scheduler {
 	var scheduler tasks.Scheduler[Executable]
 
+	channelWeightUpdateCh := make(chan struct{}, 1)
+	priorityTiers := newPriorityTiers(
+		options.NamespacePriorityTier,
+		options.PriorityTierWeights,
+		channelWeightUpdateCh,
+		metricsHandler,
+	)
+
 	taskChannelKeyFn := func(e Executable) TaskChannelKey {
 		return TaskChannelKey{
 			NamespaceID: e.GetNamespaceID(),
@@ -129,12 +152,12 @@ func NewScheduler(
 			)
 		}
 
-		return configs.ConvertDynamicConfigValueToWeights(
+		_, tierWeight := priorityTiers.weight(namespaceName)
+		return tierWeight * configs.ConvertDynamicConfigValueToWeights(
 			namespaceWeights(namespaceName.String()),
 			logger,
 		)[key.Priority]
 	}
-	channelWeightUpdateCh := make(chan struct{}, 1)
 	fifoSchedulerOptions := &tasks.FIFOSchedulerOptions{
 		QueueSize:   prioritySchedulerProcessorQueueSize,
 		WorkerCount: options.WorkerCount,
@@ -156,6 +179,8 @@ func NewScheduler(
 	return &schedulerImpl{
 		Scheduler:             scheduler,
 		namespaceRegistry:     namespaceRegistry,
+		priorityTiers:         priorityTiers,
+		metricsHandler:        metricsHandler,
 		taskChannelKeyFn:      taskChannelKeyFn,
 		channelWeightFn:       channelWeightFn,
 		channelWeightUpdateCh: channelWeightUpdateCh,
@@ -165,11 +190,15 @@ func NewScheduler(
 func (s *schedulerImpl) Start() {
 	if s.channelWeightUpdateCh != nil {
 		s.namespaceRegistry.RegisterStateChangeCallback(s, func(ns *namespace.Namespace, deletedFromDb bool) {
+			if deletedFromDb {
+				s.priorityTiers.forget(ns.Name())
+			}
 			select {
 			case s.channelWeightUpdateCh <- struct{}{}:
 			default:
 			}
 		})
+		s.priorityTiers.start()
 	}
 	s.Scheduler.Start()
 }
@@ -185,10 +214,38 @@ func (s *schedulerImpl) Stop() {
 		// channelWeightFn is only not nil when using host level scheduler
 		// so Stop is only called when host is shutting down, and we don't need
 		// to worry about open channels
+
+		s.priorityTiers.stop()
 	}
 	s.Scheduler.Stop()
 }
 
+func (s *schedulerImpl) Submit(executable Executable) {
+	s.recordPriorityTier(executable)
+	s.Scheduler.Submit(executable)
+}
+
+func (s *schedulerImpl) TrySubmit(executable Executable) bool {
+	if !s.Scheduler.TrySubmit(executable) {
+		return false
+	}
+	s.recordPriorityTier(executable)
+	return true
+}
+
+// recordPriorityTier samples submitted tasks for the priority tier request metric, so that
+// most submissions don't pay for the tier lookup and the tagged metric.
+func (s *schedulerImpl) recordPriorityTier(executable Executable) {
+	if s.submitCount.Add(1)%priorityTierMetricSampleRate != 0 {
+		return
+	}
+	metrics.TaskSchedulerPriorityTierRequests.With(s.metricsHandler).Record(
+		priorityTierMetricSampleRate,
+		metrics.PriorityTierTag(s.priorityTiers.namespaceTier(executable.GetNamespaceName())),
+		metrics.TaskPriorityTag(executable.GetPriority().String()),
+	)
+}
+
 func (s *schedulerImpl) TaskChannelKeyFn() TaskChannelKeyFn {
 	return s.taskChannelKeyFn
 }
@@ -0,0 +1,198 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	// DefaultPriorityTier is the priority tier of namespaces that are not explicitly
+	// assigned to one.
+	DefaultPriorityTier = "default"
+
+	defaultPriorityTierWeight = 1
+)
+
+type (
+	// priorityTiers keeps track of the priority tier each namespace belongs to and
+	// the weight of each tier. Both are backed by dynamic config subscriptions and
+	// the update channel is notified whenever either of them changes, so that
+	// task channel weights can be recalculated without a restart.
+	priorityTiers struct {
+		namespaceTierFn dynamicconfig.TypedSubscribableWithNamespaceFilter[string]
+		tierWeightsFn   dynamicconfig.TypedSubscribable[map[string]int]
+		updateCh        chan<- struct{}
+		metricsHandler  metrics.Handler
+
+		tierWeights       atomic.Value // map[string]int
+		cancelTierWeights func()
+
+		// namespaces is read without locking on the submit path, the mutex only
+		// serializes adding and removing subscriptions
+		namespaces sync.Map // namespace.Name -> *namespacePriorityTier
+		sync.Mutex
+		stopped bool
+	}
+
+	namespacePriorityTier struct {
+		tier   atomic.Value // string
+		cancel func()
+	}
+)
+
+func newPriorityTiers(
+	namespaceTierFn dynamicconfig.TypedSubscribableWithNamespaceFilter[string],
+	tierWeightsFn dynamicconfig.TypedSubscribable[map[string]int],
+	updateCh chan<- struct{},
+	metricsHandler metrics.Handler,
+) *priorityTiers {
+	return &priorityTiers{
+		namespaceTierFn: namespaceTierFn,
+		tierWeightsFn:   tierWeightsFn,
+		updateCh:        updateCh,
+		metricsHandler:  metricsHandler,
+	}
+}
+
+func (p *priorityTiers) start() {
+	if p.tierWeightsFn == nil {
+		return
+	}
+
+	weights, cancel := p.tierWeightsFn(func(weights map[string]int) {
+		p.tierWeights.Store(weights)
+		p.notifyUpdate()
+	})
+	p.tierWeights.CompareAndSwap(nil, weights)
+	p.cancelTierWeights = cancel
+}
+
+func (p *priorityTiers) stop() {
+	p.Lock()
+	p.stopped = true
+	var namespaces []*namespacePriorityTier
+	p.namespaces.Range(func(key, value any) bool {
+		p.namespaces.Delete(key)
+		namespaces = append(namespaces, value.(*namespacePriorityTier))
+		return true
+	})
+	p.Unlock()
+
+	for _, nsTier := range namespaces {
+		nsTier.cancel()
+	}
+	if p.cancelTierWeights != nil {
+		p.cancelTierWeights()
+	}
+}
+
+// weight returns the priority tier of the given namespace and the weight
+// multiplier for task channels of that namespace.
+func (p *priorityTiers) weight(namespaceName namespace.Name) (string, int) {
+	tier := p.namespaceTier(namespaceName)
+
+	weight := defaultPriorityTierWeight
+	if weights, ok := p.tierWeights.Load().(map[string]int); ok {
+		if tierWeight, ok := weights[tier]; ok && tierWeight > defaultPriorityTierWeight {
+			weight = tierWeight
+		}
+	}
+
+	metrics.TaskSchedulerPriorityTierWeight.With(p.metricsHandler).Record(
+		float64(weight),
+		metrics.PriorityTierTag(tier),
+	)
+	return tier, weight
+}
+
+// namespaceTier returns the priority tier of the given namespace, subscribing
+// to changes of the tier the first time the namespace is seen.
+func (p *priorityTiers) namespaceTier(namespaceName namespace.Name) string {
+	if p.namespaceTierFn == nil || namespaceName == namespace.EmptyName {
+		return DefaultPriorityTier
+	}
+
+	if nsTier, ok := p.namespaces.Load(namespaceName); ok {
+		return nsTier.(*namespacePriorityTier).get()
+	}
+
+	// subscribe without holding the lock, as dynamic config may be dispatching
+	// updates to other subscriptions of this struct at the same time.
+	newTier := &namespacePriorityTier{}
+	tier, cancel := p.namespaceTierFn(namespaceName.String(), func(tier string) {
+		newTier.tier.Store(tier)
+		p.notifyUpdate()
+	})
+	// the callback may have already stored a newer value
+	newTier.tier.CompareAndSwap(nil, tier)
+	newTier.cancel = cancel
+
+	p.Lock()
+	nsTier, ok := p.namespaces.Load(namespaceName)
+	added := !ok && !p.stopped
+	if added {
+		p.namespaces.Store(namespaceName, newTier)
+	}
+	p.Unlock()
+
+	if !added {
+		cancel()
+	}
+	if ok {
+		return nsTier.(*namespacePriorityTier).get()
+	}
+	return newTier.get()
+}
+
+// forget drops the tier subscription of the given namespace.
+func (p *priorityTiers) forget(namespaceName namespace.Name) {
+	p.Lock()
+	nsTier, ok := p.namespaces.LoadAndDelete(namespaceName)
+	p.Unlock()
+
+	if ok {
+		nsTier.(*namespacePriorityTier).cancel()
+	}
+}
+
+func (p *priorityTiers) notifyUpdate() {
+	select {
+	case p.updateCh <- struct{}{}:
+	default:
+	}
+}
+
+func (t *namespacePriorityTier) get() string {
+	if tier, ok := t.tier.Load().(string); ok && tier != "" {
+		return tier
+	}
+	return DefaultPriorityTier
+}
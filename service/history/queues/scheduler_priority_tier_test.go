@@ -0,0 +1,194 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	priorityTiersSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		namespaceTiers      map[string]string
+		namespaceCallbacks  map[string]func(string)
+		namespaceCancelled  map[string]bool
+		tierWeights         map[string]int
+		tierWeightsCallback func(map[string]int)
+		tierWeightsCanceled bool
+
+		updateCh       chan struct{}
+		metricsHandler *metricstest.CaptureHandler
+
+		priorityTiers *priorityTiers
+	}
+)
+
+func TestPriorityTiersSuite(t *testing.T) {
+	s := new(priorityTiersSuite)
+	suite.Run(t, s)
+}
+
+func (s *priorityTiersSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.namespaceTiers = map[string]string{
+		"critical-namespace": "critical",
+		"batch-namespace":    "batch",
+	}
+	s.namespaceCallbacks = make(map[string]func(string))
+	s.namespaceCancelled = make(map[string]bool)
+	s.tierWeights = map[string]int{
+		"critical": 10,
+		"batch":    0,
+	}
+	s.tierWeightsCallback = nil
+	s.tierWeightsCanceled = false
+
+	s.updateCh = make(chan struct{}, 1)
+	s.metricsHandler = metricstest.NewCaptureHandler()
+
+	s.priorityTiers = newPriorityTiers(
+		func(namespaceName string, callback func(string)) (string, func()) {
+			s.namespaceCallbacks[namespaceName] = callback
+			return s.namespaceTiers[namespaceName], func() {
+				s.namespaceCancelled[namespaceName] = true
+			}
+		},
+		func(callback func(map[string]int)) (map[string]int, func()) {
+			s.tierWeightsCallback = callback
+			return s.tierWeights, func() {
+				s.tierWeightsCanceled = true
+			}
+		},
+		s.updateCh,
+		s.metricsHandler,
+	)
+	s.priorityTiers.start()
+}
+
+func (s *priorityTiersSuite) TestWeight() {
+	capture := s.metricsHandler.StartCapture()
+	defer s.metricsHandler.StopCapture(capture)
+
+	tier, weight := s.priorityTiers.weight("critical-namespace")
+	s.Equal("critical", tier)
+	s.Equal(10, weight)
+
+	// weights less than 1 are treated as 1
+	tier, weight = s.priorityTiers.weight("batch-namespace")
+	s.Equal("batch", tier)
+	s.Equal(1, weight)
+
+	tier, weight = s.priorityTiers.weight("other-namespace")
+	s.Equal(DefaultPriorityTier, tier)
+	s.Equal(1, weight)
+
+	tier, weight = s.priorityTiers.weight(namespace.EmptyName)
+	s.Equal(DefaultPriorityTier, tier)
+	s.Equal(1, weight)
+
+	recordings := capture.Snapshot()[metrics.TaskSchedulerPriorityTierWeight.Name()]
+	s.Len(recordings, 4)
+	s.Equal(float64(10), recordings[0].Value)
+	s.Equal("critical", recordings[0].Tags[metrics.PriorityTierTagName])
+	s.Equal(DefaultPriorityTier, recordings[3].Tags[metrics.PriorityTierTagName])
+}
+
+func (s *priorityTiersSuite) TestWeight_NoTierConfig() {
+	priorityTiers := newPriorityTiers(nil, nil, s.updateCh, metrics.NoopMetricsHandler)
+	priorityTiers.start()
+	defer priorityTiers.stop()
+
+	tier, weight := priorityTiers.weight("critical-namespace")
+	s.Equal(DefaultPriorityTier, tier)
+	s.Equal(1, weight)
+}
+
+func (s *priorityTiersSuite) TestNamespaceTier_SubscribeOnce() {
+	s.Equal("critical", s.priorityTiers.namespaceTier("critical-namespace"))
+	s.namespaceCallbacks = make(map[string]func(string))
+
+	s.Equal("critical", s.priorityTiers.namespaceTier("critical-namespace"))
+	s.Empty(s.namespaceCallbacks)
+}
+
+func (s *priorityTiersSuite) TestNamespaceTier_LiveUpdate() {
+	s.Equal("batch", s.priorityTiers.namespaceTier("batch-namespace"))
+	s.Empty(s.updateCh)
+
+	s.namespaceCallbacks["batch-namespace"]("critical")
+	s.Len(s.updateCh, 1)
+	<-s.updateCh
+
+	tier, weight := s.priorityTiers.weight("batch-namespace")
+	s.Equal("critical", tier)
+	s.Equal(10, weight)
+}
+
+func (s *priorityTiersSuite) TestTierWeights_LiveUpdate() {
+	s.tierWeightsCallback(map[string]int{
+		"critical": 20,
+		"batch":    5,
+	})
+	s.Len(s.updateCh, 1)
+
+	_, weight := s.priorityTiers.weight("critical-namespace")
+	s.Equal(20, weight)
+	_, weight = s.priorityTiers.weight("batch-namespace")
+	s.Equal(5, weight)
+}
+
+func (s *priorityTiersSuite) TestForget() {
+	s.priorityTiers.namespaceTier("critical-namespace")
+
+	s.priorityTiers.forget("critical-namespace")
+	s.True(s.namespaceCancelled["critical-namespace"])
+
+	// namespace is subscribed again the next time it's seen
+	s.namespaceCallbacks = make(map[string]func(string))
+	s.priorityTiers.namespaceTier("critical-namespace")
+	s.Contains(s.namespaceCallbacks, "critical-namespace")
+}
+
+func (s *priorityTiersSuite) TestStop() {
+	s.priorityTiers.namespaceTier("critical-namespace")
+
+	s.priorityTiers.stop()
+	s.True(s.namespaceCancelled["critical-namespace"])
+	s.True(s.tierWeightsCanceled)
+
+	// no new subscription is kept after stop
+	s.Equal("batch", s.priorityTiers.namespaceTier("batch-namespace"))
+	s.True(s.namespaceCancelled["batch-namespace"])
+}
@@ -114,6 +114,8 @@ type (
 		GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *historyservice.GetWorkflowExecutionRawHistoryV2Request) (*historyservice.GetWorkflowExecutionRawHistoryV2Response, error)
 		AddTasks(ctx context.Context, request *historyservice.AddTasksRequest) (*historyservice.AddTasksResponse, error)
 		ListTasks(ctx context.Context, request *historyservice.ListTasksRequest) (*historyservice.ListTasksResponse, error)
+		DescribeHistoryQueue(ctx context.Context, request *historyservice.DescribeHistoryQueueRequest) (*historyservice.DescribeHistoryQueueResponse, error)
+		ExecuteHistoryQueueAction(ctx context.Context, request *historyservice.ExecuteHistoryQueueActionRequest) (*historyservice.ExecuteHistoryQueueActionResponse, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTasks(tasks map[tasks.Category][]tasks.Task)
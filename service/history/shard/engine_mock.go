@@ -130,6 +130,21 @@ func (mr *MockEngineMockRecorder) DeleteWorkflowExecution(ctx, deleteRequest int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DeleteWorkflowExecution), ctx, deleteRequest)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockEngine) DescribeHistoryQueue(ctx context.Context, request *v12.DescribeHistoryQueueRequest) (*v12.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", ctx, request)
+	ret0, _ := ret[0].(*v12.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockEngineMockRecorder) DescribeHistoryQueue(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockEngine)(nil).DescribeHistoryQueue), ctx, request)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockEngine) DescribeMutableState(ctx context.Context, request *v12.DescribeMutableStateRequest) (*v12.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +175,21 @@ func (mr *MockEngineMockRecorder) DescribeWorkflowExecution(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DescribeWorkflowExecution), ctx, request)
 }
 
+// ExecuteHistoryQueueAction mocks base method.
+func (m *MockEngine) ExecuteHistoryQueueAction(ctx context.Context, request *v12.ExecuteHistoryQueueActionRequest) (*v12.ExecuteHistoryQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteHistoryQueueAction", ctx, request)
+	ret0, _ := ret[0].(*v12.ExecuteHistoryQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteHistoryQueueAction indicates an expected call of ExecuteHistoryQueueAction.
+func (mr *MockEngineMockRecorder) ExecuteHistoryQueueAction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteHistoryQueueAction", reflect.TypeOf((*MockEngine)(nil).ExecuteHistoryQueueAction), ctx, request)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockEngine) ExecuteMultiOperation(ctx context.Context, request *v12.ExecuteMultiOperationRequest) (*v12.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -80,9 +80,12 @@ func NewTimerQueueFactory(
 					WorkerCount:             params.Config.TimerProcessorSchedulerWorkerCount,
 					ActiveNamespaceWeights:  params.Config.TimerProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights: params.Config.TimerProcessorSchedulerStandbyRoundRobinWeights,
+					NamespacePriorityTier:   params.Config.TaskSchedulerNamespacePriorityTier,
+					PriorityTierWeights:     params.Config.TaskSchedulerPriorityTierWeights,
 				},
 				params.NamespaceRegistry,
 				params.Logger,
+				params.MetricsHandler,
 			),
 			HostPriorityAssigner: queues.NewPriorityAssigner(),
 			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
@@ -83,9 +83,12 @@ func NewTransferQueueFactory(
 					WorkerCount:             params.Config.TransferProcessorSchedulerWorkerCount,
 					ActiveNamespaceWeights:  params.Config.TransferProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights: params.Config.TransferProcessorSchedulerStandbyRoundRobinWeights,
+					NamespacePriorityTier:   params.Config.TaskSchedulerNamespacePriorityTier,
+					PriorityTierWeights:     params.Config.TaskSchedulerPriorityTierWeights,
 				},
 				params.NamespaceRegistry,
 				params.Logger,
+				params.MetricsHandler,
 			),
 			HostPriorityAssigner: queues.NewPriorityAssigner(),
 			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
@@ -68,9 +68,12 @@ func NewVisibilityQueueFactory(
 					WorkerCount:             params.Config.VisibilityProcessorSchedulerWorkerCount,
 					ActiveNamespaceWeights:  params.Config.VisibilityProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights: params.Config.VisibilityProcessorSchedulerStandbyRoundRobinWeights,
+					NamespacePriorityTier:   params.Config.TaskSchedulerNamespacePriorityTier,
+					PriorityTierWeights:     params.Config.TaskSchedulerPriorityTierWeights,
 				},
 				params.NamespaceRegistry,
 				params.Logger,
+				params.MetricsHandler,
 			),
 			HostPriorityAssigner: queues.NewPriorityAssigner(),
 			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
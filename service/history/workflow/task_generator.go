@@ -254,7 +254,7 @@ func (r *TaskGeneratorImpl) GenerateWorkflowCloseTasks(
 				delay = retention
 			}
 			// archiveTime is the time when the archival queue recognizes the ArchiveExecutionTask as ready-to-process
-			archiveTime := closedTime.Add(delay)
+			archiveTime := r.retentionStartTime(closedTime).Add(delay)
 
 			task := &tasks.ArchiveExecutionTask{
 				// TaskID is set by the shard
@@ -293,6 +293,16 @@ func (r *TaskGeneratorImpl) getRetention() (time.Duration, error) {
 	return retention, nil
 }
 
+// retentionStartTime returns the time from which the retention of a closed workflow is counted: its close time, or
+// the time it was restored from archive if that is later.
+func (r *TaskGeneratorImpl) retentionStartTime(closeTime time.Time) time.Time {
+	restoreTime := r.mutableState.GetExecutionInfo().GetRestoreTime()
+	if restoreTime != nil && restoreTime.AsTime().After(closeTime) {
+		return restoreTime.AsTime()
+	}
+	return closeTime
+}
+
 func (r *TaskGeneratorImpl) GenerateDirtySubStateMachineTasks(
 	stateMachineRegistry *hsm.Registry,
 ) error {
@@ -345,7 +355,7 @@ func (r *TaskGeneratorImpl) GenerateDeleteHistoryEventTask(closeTime time.Time)
 	}
 
 	retentionJitterDuration := backoff.FullJitter(r.config.RetentionTimerJitterDuration())
-	deleteTime := closeTime.Add(retention).Add(retentionJitterDuration)
+	deleteTime := r.retentionStartTime(closeTime).Add(retention).Add(retentionJitterDuration)
 	r.mutableState.AddTasks(&tasks.DeleteHistoryEventTask{
 		// TaskID is set by shard
 		WorkflowKey:         r.mutableState.GetWorkflowKey(),
@@ -64,6 +64,7 @@ type testConfig struct {
 type testParams struct {
 	DeleteAfterClose                     bool
 	CloseEventTime                       time.Time
+	RestoreTime                          time.Time
 	Retention                            time.Duration
 	Logger                               *log.MockLogger
 	ArchivalProcessorArchiveDelay        time.Duration
@@ -175,6 +176,29 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				p.ExpectArchiveExecutionTask = false
 			},
 		},
+		{
+			Name: "restored after retention expired",
+			ConfigFn: func(p *testParams) {
+				p.HistoryArchivalEnabledInNamespace = false
+				p.VisibilityArchivalEnabledInNamespace = false
+				p.RestoreTime = time.Unix(0, 0).Add(30 * 24 * time.Hour)
+
+				p.ExpectCloseExecutionVisibilityTask = true
+				p.ExpectDeleteHistoryEventTask = true
+				p.ExpectArchiveExecutionTask = false
+			},
+		},
+		{
+			Name: "restored after retention expired with archival enabled",
+			ConfigFn: func(p *testParams) {
+				p.RestoreTime = time.Unix(0, 0).Add(30 * 24 * time.Hour)
+				p.ArchivalProcessorArchiveDelay = 0
+
+				p.ExpectedArchiveExecutionTaskVisibilityTimestamp = p.RestoreTime
+				p.ExpectCloseExecutionVisibilityTask = true
+				p.ExpectArchiveExecutionTask = true
+			},
+		},
 	} {
 		c := c
 		t.Run(c.Name, func(t *testing.T) {
@@ -240,9 +264,13 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 			mutableState.EXPECT().GetNamespaceEntry().Return(namespaceEntry).AnyTimes()
 			mutableState.EXPECT().GetCloseVersion().Return(int64(0), nil).AnyTimes()
 			mutableState.EXPECT().GetExecutionInfo().DoAndReturn(func() *persistencespb.WorkflowExecutionInfo {
-				return &persistencespb.WorkflowExecutionInfo{
+				executionInfo := &persistencespb.WorkflowExecutionInfo{
 					NamespaceId: namespaceEntry.ID().String(),
 				}
+				if !p.RestoreTime.IsZero() {
+					executionInfo.RestoreTime = timestamppb.New(p.RestoreTime)
+				}
+				return executionInfo
 			}).AnyTimes()
 			mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
 				namespaceEntry.ID().String(), tests.WorkflowID, tests.RunID,
@@ -257,7 +285,10 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 					return p.ArchivalProcessorArchiveDelay
 				},
 			}
-			closeTime := time.Unix(0, 0)
+			retentionStartTime := time.Unix(0, 0)
+			if !p.RestoreTime.IsZero() {
+				retentionStartTime = p.RestoreTime
+			}
 			var allTasks []tasks.Task
 			mutableState.EXPECT().AddTasks(gomock.Any()).Do(func(ts ...tasks.Task) {
 				allTasks = append(allTasks, ts...)
@@ -312,6 +343,7 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				assert.Equal(t, archiveExecutionTask.RunID, tests.RunID)
 				assert.True(t, p.ExpectedArchiveExecutionTaskVisibilityTimestamp.Equal(archiveExecutionTask.VisibilityTimestamp) ||
 					p.ExpectedArchiveExecutionTaskVisibilityTimestamp.After(archiveExecutionTask.VisibilityTimestamp))
+				assert.False(t, archiveExecutionTask.VisibilityTimestamp.Before(retentionStartTime))
 			} else {
 				assert.Nil(t, archiveExecutionTask)
 			}
@@ -320,9 +352,9 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				assert.Equal(t, deleteHistoryEventTask.NamespaceID, namespaceEntry.ID().String())
 				assert.Equal(t, deleteHistoryEventTask.WorkflowID, tests.WorkflowID)
 				assert.Equal(t, deleteHistoryEventTask.RunID, tests.RunID)
-				assert.GreaterOrEqual(t, deleteHistoryEventTask.VisibilityTimestamp, closeTime.Add(p.Retention))
+				assert.GreaterOrEqual(t, deleteHistoryEventTask.VisibilityTimestamp, retentionStartTime.Add(p.Retention))
 				assert.LessOrEqual(t, deleteHistoryEventTask.VisibilityTimestamp,
-					closeTime.Add(p.Retention).Add(retentionTimerDelay*2))
+					retentionStartTime.Add(p.Retention).Add(retentionTimerDelay*2))
 			} else {
 				assert.Nil(t, deleteHistoryEventTask)
 			}
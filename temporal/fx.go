@@ -223,7 +223,7 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 	if dcClient == nil {
 		dcConfig := so.config.DynamicConfigClient
 		if dcConfig != nil {
-			dcClient, err = dynamicconfig.NewFileBasedClient(dcConfig, logger, stopChan)
+			dcClient, err = dynamicconfig.NewClientFromConfig(dcConfig, logger, stopChan)
 			if err != nil {
 				return serverOptionsProvider{}, fmt.Errorf("unable to create dynamic config client: %w", err)
 			}
@@ -483,6 +483,141 @@ func AdminRemoveTask(
 	return nil
 }
 
+// AdminDescribeHistoryQueue describes the readers, slices and alerts of a history queue
+func AdminDescribeHistoryQueue(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) error {
+	adminClient := clientFactory.AdminClient(c)
+	category, err := getCategory(taskCategoryRegistry, c.String(FlagTaskCategory))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	response, err := adminClient.DescribeHistoryQueue(ctx, &adminservice.DescribeHistoryQueueRequest{
+		ShardId:  int32(c.Int(FlagShardID)),
+		Category: int32(category.ID()),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe history queue: %s", err)
+	}
+
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminSplitHistoryQueueSlices moves slices of a history queue reader to the next reader
+func AdminSplitHistoryQueueSlices(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) error {
+	category, err := getCategory(taskCategoryRegistry, c.String(FlagTaskCategory))
+	if err != nil {
+		return err
+	}
+
+	var taskRange *history.TaskRange
+	if c.IsSet(FlagMinTaskID) || c.IsSet(FlagMaxTaskID) ||
+		c.IsSet(FlagMinVisibilityTimestamp) || c.IsSet(FlagMaxVisibilityTimestamp) {
+		taskRange = &history.TaskRange{}
+		if c.IsSet(FlagMinTaskID) || c.IsSet(FlagMinVisibilityTimestamp) {
+			minFireTime, err := parseTime(c.String(FlagMinVisibilityTimestamp), time.Unix(0, 0), time.Now().UTC())
+			if err != nil {
+				return err
+			}
+			taskRange.InclusiveMinTaskKey = &history.TaskKey{
+				FireTime: timestamppb.New(minFireTime),
+				TaskId:   c.Int64(FlagMinTaskID),
+			}
+		}
+		if c.IsSet(FlagMaxTaskID) || c.IsSet(FlagMaxVisibilityTimestamp) {
+			maxFireTime, err := parseTime(c.String(FlagMaxVisibilityTimestamp), time.Unix(0, 0), time.Now().UTC())
+			if err != nil {
+				return err
+			}
+			taskRange.ExclusiveMaxTaskKey = &history.TaskKey{
+				FireTime: timestamppb.New(maxFireTime),
+				TaskId:   c.Int64(FlagMaxTaskID),
+			}
+		}
+	}
+
+	return executeHistoryQueueAction(c, clientFactory, &adminservice.ExecuteHistoryQueueActionRequest{
+		ShardId:  int32(c.Int(FlagShardID)),
+		Category: int32(category.ID()),
+		Action: &adminservice.ExecuteHistoryQueueActionRequest_Split{
+			Split: &adminservice.HistoryQueueSplitAction{
+				ReaderId:  c.Int64(FlagReaderID),
+				TaskRange: taskRange,
+			},
+		},
+	})
+}
+
+// AdminShrinkHistoryQueueSlices shrinks slices of all readers of a history queue
+func AdminShrinkHistoryQueueSlices(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) error {
+	category, err := getCategory(taskCategoryRegistry, c.String(FlagTaskCategory))
+	if err != nil {
+		return err
+	}
+
+	return executeHistoryQueueAction(c, clientFactory, &adminservice.ExecuteHistoryQueueActionRequest{
+		ShardId:  int32(c.Int(FlagShardID)),
+		Category: int32(category.ID()),
+		Action: &adminservice.ExecuteHistoryQueueActionRequest_Shrink{
+			Shrink: &adminservice.HistoryQueueShrinkAction{},
+		},
+	})
+}
+
+// AdminPauseHistoryQueueReader pauses a history queue reader for the given duration
+func AdminPauseHistoryQueueReader(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) error {
+	category, err := getCategory(taskCategoryRegistry, c.String(FlagTaskCategory))
+	if err != nil {
+		return err
+	}
+
+	return executeHistoryQueueAction(c, clientFactory, &adminservice.ExecuteHistoryQueueActionRequest{
+		ShardId:  int32(c.Int(FlagShardID)),
+		Category: int32(category.ID()),
+		Action: &adminservice.ExecuteHistoryQueueActionRequest_Pause{
+			Pause: &adminservice.HistoryQueuePauseAction{
+				ReaderId: c.Int64(FlagReaderID),
+				Duration: durationpb.New(c.Duration(FlagDuration)),
+			},
+		},
+	})
+}
+
+func executeHistoryQueueAction(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	request *adminservice.ExecuteHistoryQueueActionRequest,
+) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if _, err := adminClient.ExecuteHistoryQueueAction(ctx, request); err != nil {
+		return fmt.Errorf("unable to execute history queue action: %s", err)
+	}
+	return nil
+}
+
 // AdminDescribeShard describes shard by shard id
 func AdminDescribeShard(c *cli.Context, clientFactory ClientFactory) error {
 	sid := c.Int(FlagShardID)
@@ -693,3 +828,37 @@ func AdminRebuildMutableState(c *cli.Context, clientFactory ClientFactory) error
 	}
 	return nil
 }
+
+// AdminRestoreWorkflowFromArchive restores an archived workflow execution back into the database
+func AdminRestoreWorkflowFromArchive(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.RestoreWorkflowExecutionFromArchive(ctx, &adminservice.RestoreWorkflowExecutionFromArchiveRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("restore workflow from archive failed: %s", err)
+	}
+	fmt.Fprintf(c.App.Writer, "restore workflow from archive succeeded, %d events restored.\n", resp.GetEventCount())
+	return nil
+}
@@ -86,4 +86,6 @@ var (
 	FlagBase64File                 = "base64-file"
 	FlagTaskCategoryID             = "task-category-id"
 	FlagEncoding                   = "encoding"
+	FlagReaderID                   = "reader-id"
+	FlagDuration                   = "duration"
 )
@@ -218,6 +218,25 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminRebuildMutableState(c, clientFactory)
 			},
 		},
+		{
+			Name:  "restore-from-archive",
+			Usage: "Restore an archived workflow execution back into the database",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRestoreWorkflowFromArchive(c, clientFactory)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -354,6 +373,120 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminRemoveTask(c, clientFactory, taskCategoryRegistry)
 			},
 		},
+		{
+			Name:        "queue",
+			Aliases:     []string{"q"},
+			Usage:       "Inspect and operate history queues of a shard",
+			Subcommands: newAdminHistoryQueueCommands(clientFactory, taskCategoryRegistry),
+		},
+	}
+}
+
+func newAdminHistoryQueueCommands(clientFactory ClientFactory, taskCategoryRegistry tasks.TaskCategoryRegistry) []*cli.Command {
+	newQueueCategoryFlag := func() *cli.StringFlag {
+		flag := getTaskCategoryFlag(taskCategoryRegistry)
+		flag.Required = true
+		return flag
+	}
+
+	return []*cli.Command{
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe readers, slices, pending task counts and alerts of a history queue",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				newQueueCategoryFlag(),
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeHistoryQueue(c, clientFactory, taskCategoryRegistry)
+			},
+		},
+		{
+			Name: "split",
+			Usage: "Move slices of a reader to the next reader. If a task range is specified, only the part of slices " +
+				"within the range is moved, otherwise slices with non-universal predicates are moved",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				newQueueCategoryFlag(),
+				&cli.Int64Flag{
+					Name:     FlagReaderID,
+					Usage:    "The ID of the reader whose slices will be split",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  FlagMinTaskID,
+					Usage: "Inclusive min taskID of the range to split",
+				},
+				&cli.Int64Flag{
+					Name:  FlagMaxTaskID,
+					Usage: "Exclusive max taskID of the range to split",
+				},
+				&cli.StringFlag{
+					Name: FlagMinVisibilityTimestamp,
+					Usage: "Inclusive min task fire timestamp of the range to split. Only applies to scheduled task categories. " +
+						"Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, " +
+						"minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes.",
+				},
+				&cli.StringFlag{
+					Name: FlagMaxVisibilityTimestamp,
+					Usage: "Exclusive max task fire timestamp of the range to split. Only applies to scheduled task categories. " +
+						"Supported formats are the same as " + FlagMinVisibilityTimestamp,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSplitHistoryQueueSlices(c, clientFactory, taskCategoryRegistry)
+			},
+		},
+		{
+			Name:  "shrink",
+			Usage: "Shrink slices of all readers, dropping acknowledged tasks and empty slices",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				newQueueCategoryFlag(),
+			},
+			Action: func(c *cli.Context) error {
+				return AdminShrinkHistoryQueueSlices(c, clientFactory, taskCategoryRegistry)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause loading tasks for a reader",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				newQueueCategoryFlag(),
+				&cli.Int64Flag{
+					Name:     FlagReaderID,
+					Usage:    "The ID of the reader to pause",
+					Required: true,
+				},
+				&cli.DurationFlag{
+					Name:     FlagDuration,
+					Usage:    "How long the reader will be paused, e.g. 30s, 5m",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseHistoryQueueReader(c, clientFactory, taskCategoryRegistry)
+			},
+		},
 	}
 }
 